@@ -8,7 +8,9 @@ import (
 	"os"
 
 	"github.com/newstack-cloud/celerity-provider-aws/provider"
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
 	"github.com/newstack-cloud/celerity-provider-aws/services/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/services/s3"
 	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/plugin"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/pluginservicev1"
@@ -30,6 +32,8 @@ func main() {
 	providerServer := providerv1.NewProviderPlugin(
 		provider.NewProvider(
 			lambda.NewService,
+			s3.NewService,
+			ecr.NewService,
 			utils.NewAWSConfigStore(
 				os.Environ(),
 				utils.AWSConfigFromProviderContext,
@@ -4,7 +4,9 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
 	"github.com/newstack-cloud/celerity-provider-aws/services/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/services/s3"
 	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
@@ -15,6 +17,8 @@ import (
 
 func NewProvider(
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambda.Service],
+	s3ServiceFactory pluginutils.ServiceFactory[*aws.Config, s3.Service],
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecr.Service],
 	awsConfigStore *utils.AWSConfigStore,
 ) provider.Provider {
 	return &providerv1.ProviderPluginDefinition{
@@ -22,12 +26,59 @@ func NewProvider(
 		ProviderConfigDefinition: providerConfigDefinition(),
 		Resources: map[string]provider.Resource{
 			"aws/lambda/function": lambda.FunctionResource(
+				lambdaServiceFactory,
+				s3ServiceFactory,
+				ecrServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/functionVersion": lambda.FunctionVersionResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/alias": lambda.AliasResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/permission": lambda.PermissionResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/eventSourceMapping": lambda.EventSourceMappingResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/url": lambda.URLResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/layerVersion": lambda.LayerVersionResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/layerVersionPermission": lambda.LayerVersionPermissionResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/codeSigningConfig": lambda.CodeSigningConfigResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/eventInvokeConfig": lambda.EventInvokeConfigResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/lambda/provisionedConcurrencyConfig": lambda.ProvisionedConcurrencyConfigResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+		},
+		DataSources: map[string]provider.DataSource{},
+		Links: map[string]provider.Link{
+			"aws/lambda/function::aws/lambda/codeSigningConfig": lambda.FunctionCodeSigningConfigLink(
 				lambdaServiceFactory,
 				awsConfigStore,
 			),
 		},
-		DataSources:         map[string]provider.DataSource{},
-		Links:               map[string]provider.Link{},
 		CustomVariableTypes: map[string]provider.CustomVariableType{},
 		Functions:           map[string]provider.Function{},
 	}
@@ -49,6 +100,14 @@ func providerConfigDefinition() *core.ConfigDefinition {
 					"This can be retrieved from the 'Security & Credentials' section of the AWS console.",
 				Secret: true,
 			},
+			"codeStagingBucket": {
+				Type:  core.ScalarTypeString,
+				Label: "Code Staging Bucket",
+				Description: "The name of an Amazon S3 bucket used to stage Lambda function deployment packages " +
+					"that are too large to be uploaded directly. Packages are stored with keys derived from their " +
+					"contents so unchanged packages are not uploaded again. " +
+					"This can be overridden for a function with the `code.stagingBucket` field.",
+			},
 			"customCABundle": {
 				Type:  core.ScalarTypeString,
 				Label: "Custom CA Bundle",
@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
 	"github.com/newstack-cloud/celerity-provider-aws/services/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/services/s3"
 	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/stretchr/testify/suite"
@@ -57,7 +59,7 @@ func (s *ProviderSuite) Test_loads_provider_and_applies_duration_validation() {
 		utils.AWSConfigFromProviderContext,
 		&utils.DefaultAWSConfigLoader{},
 	)
-	provider := NewProvider(lambda.NewService, configStore)
+	provider := NewProvider(lambda.NewService, s3.NewService, ecr.NewService, configStore)
 	configDef, err := provider.ConfigDefinition(context.Background())
 	s.Require().NoError(err, "should get config definition without error")
 
@@ -135,7 +137,7 @@ func (s *ProviderSuite) Test_loads_provider_and_applies_role_arn_validation() {
 		utils.AWSConfigFromProviderContext,
 		&utils.DefaultAWSConfigLoader{},
 	)
-	provider := NewProvider(lambda.NewService, configStore)
+	provider := NewProvider(lambda.NewService, s3.NewService, ecr.NewService, configStore)
 	configDef, err := provider.ConfigDefinition(context.Background())
 	s.Require().NoError(err, "should get config definition without error")
 
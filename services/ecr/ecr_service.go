@@ -0,0 +1,70 @@
+package ecr
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the
+// Amazon Elastic Container Registry (ECR) service used by resource implementations
+// that deploy container images, such as resolving image tags to digests.
+type Service interface {
+	// Returns metadata about the images in a repository.
+	//
+	// Beginning with Docker version 1.9, the Docker client compresses image layers
+	// before pushing them to a V2 Docker registry. The output of the docker images
+	// command shows the uncompressed image size. Therefore, Docker might return a
+	// larger image than the image shown in the Amazon Web Services Management Console.
+	DescribeImages(
+		ctx context.Context,
+		params *ecr.DescribeImagesInput,
+		optFns ...func(*ecr.Options),
+	) (*ecr.DescribeImagesOutput, error)
+}
+
+// NewService creates a new instance of the AWS ECR service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return ecr.NewFromConfig(
+		*awsConfig,
+		ecr.WithEndpointResolverV2(
+			&ecrEndpointResolverV2{
+				providerContext,
+			},
+		),
+	)
+}
+
+type ecrEndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (e *ecrEndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params ecr.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	ecrAliases := utils.Services["ecr"]
+	ecrEndpoint, hasECREndpoint := utils.GetEndpointFromProviderConfig(
+		e.providerContext,
+		"ecr",
+		ecrAliases,
+	)
+	if hasECREndpoint && !core.IsScalarNil(ecrEndpoint) {
+		u, err := url.Parse(core.StringValueFromScalar(ecrEndpoint))
+		if err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+		return smithyendpoints.Endpoint{
+			URI: *u,
+		}, nil
+	}
+
+	return ecr.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
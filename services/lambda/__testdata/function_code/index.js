@@ -0,0 +1,3 @@
+const { greet } = require('./lib/greet');
+
+exports.handler = async () => greet('World');
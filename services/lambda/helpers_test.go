@@ -5,43 +5,161 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrsdk "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
+	"github.com/newstack-cloud/celerity-provider-aws/services/s3"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
 )
 
+// The path to the source files used to test packaging
+// function code from a local directory.
+const testFunctionCodePath = "__testdata/function_code"
+
+// The digest that image tags used in function tests resolve to.
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+// createTestImageECRServiceMock creates an ECR service mock that resolves
+// any image tag to the provided digest.
+func createTestImageECRServiceMock(digest string) *ecrServiceMock {
+	return createECRServiceMock(
+		WithDescribeImagesOutput(&ecrsdk.DescribeImagesOutput{
+			ImageDetails: []ecrtypes.ImageDetail{
+				{
+					ImageDigest: aws.String(digest),
+				},
+			},
+		}),
+	)
+}
+
+// inlineFunctionCodeZip creates the deployment package expected to be
+// deployed for inline function code with a single file.
+func inlineFunctionCodeZip(fileName string, content string) []byte {
+	zipBytes, err := utils.ZipFilesInMemory([]utils.ArchiveFile{
+		{
+			Name:    fileName,
+			Content: []byte(content),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return zipBytes
+}
+
 type lambdaServiceMock struct {
 	plugintestutils.MockCalls
 
-	getFunctionOutput                  *lambda.GetFunctionOutput
-	getFunctionCodeSigningOutput       *lambda.GetFunctionCodeSigningConfigOutput
-	getFunctionRecursionOutput         *lambda.GetFunctionRecursionConfigOutput
-	getFunctionConcurrencyOutput       *lambda.GetFunctionConcurrencyOutput
-	getFunctionError                   error
-	getFunctionCodeSigningError        error
-	getFunctionRecursionError          error
-	getFunctionConcurrencyError        error
-	deleteFunctionOutput               *lambda.DeleteFunctionOutput
-	deleteFunctionError                error
-	updateFunctionConfigurationOutput  *lambda.UpdateFunctionConfigurationOutput
-	updateFunctionConfigurationError   error
-	updateFunctionCodeOutput           *lambda.UpdateFunctionCodeOutput
-	updateFunctionCodeError            error
-	putFunctionCodeSigningConfigOutput *lambda.PutFunctionCodeSigningConfigOutput
-	putFunctionCodeSigningConfigError  error
-	putFunctionConcurrencyOutput       *lambda.PutFunctionConcurrencyOutput
-	putFunctionConcurrencyError        error
-	putFunctionRecursionConfigOutput   *lambda.PutFunctionRecursionConfigOutput
-	putFunctionRecursionConfigError    error
-	putRuntimeManagementConfigOutput   *lambda.PutRuntimeManagementConfigOutput
-	putRuntimeManagementConfigError    error
-	tagResourceOutput                  *lambda.TagResourceOutput
-	tagResourceError                   error
-	untagResourceOutput                *lambda.UntagResourceOutput
-	untagResourceError                 error
-	createFunctionOutput               *lambda.CreateFunctionOutput
-	createFunctionError                error
+	getFunctionOutput *lambda.GetFunctionOutput
+	// Outputs returned by consecutive calls to GetFunction,
+	// once exhausted, getFunctionOutput is returned.
+	getFunctionOutputSequence                []*lambda.GetFunctionOutput
+	getFunctionCodeSigningOutput             *lambda.GetFunctionCodeSigningConfigOutput
+	getFunctionRecursionOutput               *lambda.GetFunctionRecursionConfigOutput
+	getFunctionConcurrencyOutput             *lambda.GetFunctionConcurrencyOutput
+	getFunctionError                         error
+	getFunctionCodeSigningError              error
+	getFunctionRecursionError                error
+	getFunctionConcurrencyError              error
+	deleteFunctionOutput                     *lambda.DeleteFunctionOutput
+	deleteFunctionError                      error
+	updateFunctionConfigurationOutput        *lambda.UpdateFunctionConfigurationOutput
+	updateFunctionConfigurationError         error
+	updateFunctionCodeOutput                 *lambda.UpdateFunctionCodeOutput
+	updateFunctionCodeError                  error
+	putFunctionCodeSigningConfigOutput       *lambda.PutFunctionCodeSigningConfigOutput
+	putFunctionCodeSigningConfigError        error
+	putFunctionConcurrencyOutput             *lambda.PutFunctionConcurrencyOutput
+	putFunctionConcurrencyError              error
+	putFunctionRecursionConfigOutput         *lambda.PutFunctionRecursionConfigOutput
+	putFunctionRecursionConfigError          error
+	putRuntimeManagementConfigOutput         *lambda.PutRuntimeManagementConfigOutput
+	putRuntimeManagementConfigError          error
+	tagResourceOutput                        *lambda.TagResourceOutput
+	tagResourceError                         error
+	untagResourceOutput                      *lambda.UntagResourceOutput
+	untagResourceError                       error
+	createFunctionOutput                     *lambda.CreateFunctionOutput
+	createFunctionError                      error
+	publishVersionOutput                     *lambda.PublishVersionOutput
+	publishVersionError                      error
+	listVersionsByFunctionOutput             *lambda.ListVersionsByFunctionOutput
+	listVersionsByFunctionError              error
+	putProvisionedConcurrencyConfigOutput    *lambda.PutProvisionedConcurrencyConfigOutput
+	putProvisionedConcurrencyConfigError     error
+	getProvisionedConcurrencyConfigOutput    *lambda.GetProvisionedConcurrencyConfigOutput
+	getProvisionedConcurrencyConfigError     error
+	deleteProvisionedConcurrencyConfigOutput *lambda.DeleteProvisionedConcurrencyConfigOutput
+	deleteProvisionedConcurrencyConfigError  error
+	getRuntimeManagementConfigOutput         *lambda.GetRuntimeManagementConfigOutput
+	getRuntimeManagementConfigError          error
+	createAliasOutput                        *lambda.CreateAliasOutput
+	createAliasError                         error
+	updateAliasOutput                        *lambda.UpdateAliasOutput
+	updateAliasError                         error
+	deleteAliasOutput                        *lambda.DeleteAliasOutput
+	deleteAliasError                         error
+	getAliasOutput                           *lambda.GetAliasOutput
+	getAliasError                            error
+	addPermissionOutput                      *lambda.AddPermissionOutput
+	addPermissionError                       error
+	removePermissionOutput                   *lambda.RemovePermissionOutput
+	removePermissionError                    error
+	getPolicyOutput                          *lambda.GetPolicyOutput
+	getPolicyError                           error
+	createEventSourceMappingOutput           *lambda.CreateEventSourceMappingOutput
+	createEventSourceMappingError            error
+	updateEventSourceMappingOutput           *lambda.UpdateEventSourceMappingOutput
+	updateEventSourceMappingError            error
+	deleteEventSourceMappingOutput           *lambda.DeleteEventSourceMappingOutput
+	deleteEventSourceMappingError            error
+	getEventSourceMappingOutput              *lambda.GetEventSourceMappingOutput
+	getEventSourceMappingError               error
+	createFunctionUrlConfigOutput            *lambda.CreateFunctionUrlConfigOutput
+	createFunctionUrlConfigError             error
+	updateFunctionUrlConfigOutput            *lambda.UpdateFunctionUrlConfigOutput
+	updateFunctionUrlConfigError             error
+	deleteFunctionUrlConfigOutput            *lambda.DeleteFunctionUrlConfigOutput
+	deleteFunctionUrlConfigError             error
+	getFunctionUrlConfigOutput               *lambda.GetFunctionUrlConfigOutput
+	getFunctionUrlConfigError                error
+	publishLayerVersionOutput                *lambda.PublishLayerVersionOutput
+	publishLayerVersionError                 error
+	getLayerVersionOutput                    *lambda.GetLayerVersionOutput
+	getLayerVersionError                     error
+	deleteLayerVersionOutput                 *lambda.DeleteLayerVersionOutput
+	deleteLayerVersionError                  error
+	addLayerVersionPermissionOutput          *lambda.AddLayerVersionPermissionOutput
+	addLayerVersionPermissionError           error
+	removeLayerVersionPermissionOutput       *lambda.RemoveLayerVersionPermissionOutput
+	removeLayerVersionPermissionError        error
+	getLayerVersionPolicyOutput              *lambda.GetLayerVersionPolicyOutput
+	getLayerVersionPolicyError               error
+	createCodeSigningConfigOutput            *lambda.CreateCodeSigningConfigOutput
+	createCodeSigningConfigError             error
+	updateCodeSigningConfigOutput            *lambda.UpdateCodeSigningConfigOutput
+	updateCodeSigningConfigError             error
+	deleteCodeSigningConfigOutput            *lambda.DeleteCodeSigningConfigOutput
+	deleteCodeSigningConfigError             error
+	getCodeSigningConfigOutput               *lambda.GetCodeSigningConfigOutput
+	getCodeSigningConfigError                error
+	listTagsOutput                           *lambda.ListTagsOutput
+	listTagsError                            error
+	deleteFunctionCodeSigningConfigOutput    *lambda.DeleteFunctionCodeSigningConfigOutput
+	deleteFunctionCodeSigningConfigError     error
+	putFunctionEventInvokeConfigOutput       *lambda.PutFunctionEventInvokeConfigOutput
+	putFunctionEventInvokeConfigError        error
+	getFunctionEventInvokeConfigOutput       *lambda.GetFunctionEventInvokeConfigOutput
+	getFunctionEventInvokeConfigError        error
+	deleteFunctionEventInvokeConfigOutput    *lambda.DeleteFunctionEventInvokeConfigOutput
+	deleteFunctionEventInvokeConfigError     error
 }
 
 type lambdaServiceMockOption func(*lambdaServiceMock)
@@ -75,6 +193,12 @@ func WithGetFunctionOutput(output *lambda.GetFunctionOutput) lambdaServiceMockOp
 	}
 }
 
+func WithGetFunctionOutputSequence(outputs ...*lambda.GetFunctionOutput) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getFunctionOutputSequence = outputs
+	}
+}
+
 func WithGetFunctionError(err error) lambdaServiceMockOption {
 	return func(m *lambdaServiceMock) {
 		m.getFunctionError = err
@@ -253,151 +377,1133 @@ func WithCreateFunctionError(err error) lambdaServiceMockOption {
 	}
 }
 
-func (m *lambdaServiceMock) GetFunction(
-	ctx context.Context,
-	params *lambda.GetFunctionInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.GetFunctionOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.getFunctionOutput, m.getFunctionError
+func WithPublishVersionOutput(
+	output *lambda.PublishVersionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.publishVersionOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) GetFunctionCodeSigningConfig(
-	ctx context.Context,
-	params *lambda.GetFunctionCodeSigningConfigInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
-	m.RegisterCall("GetFunctionCodeSigningConfig", ctx, params)
-	return m.getFunctionCodeSigningOutput, m.getFunctionCodeSigningError
+func WithPublishVersionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.publishVersionError = err
+	}
 }
 
-func (m *lambdaServiceMock) GetFunctionRecursionConfig(
-	ctx context.Context,
-	params *lambda.GetFunctionRecursionConfigInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.GetFunctionRecursionConfigOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.getFunctionRecursionOutput, m.getFunctionRecursionError
+func WithListVersionsByFunctionOutput(
+	output *lambda.ListVersionsByFunctionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listVersionsByFunctionOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) GetFunctionConcurrency(
-	ctx context.Context,
-	params *lambda.GetFunctionConcurrencyInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.GetFunctionConcurrencyOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.getFunctionConcurrencyOutput, m.getFunctionConcurrencyError
+func WithListVersionsByFunctionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listVersionsByFunctionError = err
+	}
 }
 
-func (m *lambdaServiceMock) DeleteFunction(
-	ctx context.Context,
-	params *lambda.DeleteFunctionInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.DeleteFunctionOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.deleteFunctionOutput, m.deleteFunctionError
+func WithPutProvisionedConcurrencyConfigOutput(
+	output *lambda.PutProvisionedConcurrencyConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.putProvisionedConcurrencyConfigOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) UpdateFunctionConfiguration(
-	ctx context.Context,
-	params *lambda.UpdateFunctionConfigurationInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.UpdateFunctionConfigurationOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.updateFunctionConfigurationOutput, m.updateFunctionConfigurationError
+func WithPutProvisionedConcurrencyConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.putProvisionedConcurrencyConfigError = err
+	}
 }
 
-func (m *lambdaServiceMock) UpdateFunctionCode(
-	ctx context.Context,
-	params *lambda.UpdateFunctionCodeInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.UpdateFunctionCodeOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.updateFunctionCodeOutput, m.updateFunctionCodeError
+func WithGetProvisionedConcurrencyConfigOutput(
+	output *lambda.GetProvisionedConcurrencyConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getProvisionedConcurrencyConfigOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) PutFunctionCodeSigningConfig(
-	ctx context.Context,
-	params *lambda.PutFunctionCodeSigningConfigInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.PutFunctionCodeSigningConfigOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.putFunctionCodeSigningConfigOutput, m.putFunctionCodeSigningConfigError
+func WithGetProvisionedConcurrencyConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getProvisionedConcurrencyConfigError = err
+	}
 }
 
-func (m *lambdaServiceMock) PutFunctionConcurrency(
-	ctx context.Context,
-	params *lambda.PutFunctionConcurrencyInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.PutFunctionConcurrencyOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.putFunctionConcurrencyOutput, m.putFunctionConcurrencyError
+func WithDeleteProvisionedConcurrencyConfigOutput(
+	output *lambda.DeleteProvisionedConcurrencyConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteProvisionedConcurrencyConfigOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) PutFunctionRecursionConfig(
-	ctx context.Context,
-	params *lambda.PutFunctionRecursionConfigInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.PutFunctionRecursionConfigOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.putFunctionRecursionConfigOutput, m.putFunctionRecursionConfigError
+func WithDeleteProvisionedConcurrencyConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteProvisionedConcurrencyConfigError = err
+	}
 }
 
-func (m *lambdaServiceMock) PutRuntimeManagementConfig(
-	ctx context.Context,
-	params *lambda.PutRuntimeManagementConfigInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.PutRuntimeManagementConfigOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.putRuntimeManagementConfigOutput, m.putRuntimeManagementConfigError
+func WithGetRuntimeManagementConfigOutput(
+	output *lambda.GetRuntimeManagementConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getRuntimeManagementConfigOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) TagResource(
-	ctx context.Context,
-	params *lambda.TagResourceInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.TagResourceOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.tagResourceOutput, m.tagResourceError
+func WithGetRuntimeManagementConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getRuntimeManagementConfigError = err
+	}
 }
 
-func (m *lambdaServiceMock) UntagResource(
-	ctx context.Context,
-	params *lambda.UntagResourceInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.UntagResourceOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.untagResourceOutput, m.untagResourceError
+func WithCreateAliasOutput(
+	output *lambda.CreateAliasOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createAliasOutput = output
+	}
 }
 
-func (m *lambdaServiceMock) CreateFunction(
-	ctx context.Context,
-	params *lambda.CreateFunctionInput,
-	optFns ...func(*lambda.Options),
-) (*lambda.CreateFunctionOutput, error) {
-	m.RegisterCall(ctx, params)
-	return m.createFunctionOutput, m.createFunctionError
+func WithCreateAliasError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createAliasError = err
+	}
 }
 
-func createBaseTestFunctionConfig(
-	functionName string,
-	runtime types.Runtime,
-	handler string,
-	role string,
-) *lambda.GetFunctionOutput {
-	return &lambda.GetFunctionOutput{
-		Configuration: &types.FunctionConfiguration{
-			FunctionName: aws.String(functionName),
-			FunctionArn:  aws.String(fmt.Sprintf("arn:aws:lambda:us-east-1:123456789012:function:%s", functionName)),
-			Runtime:      runtime,
-			Handler:      aws.String(handler),
-			Role:         aws.String(role),
-			Architectures: []types.Architecture{
-				types.ArchitectureX8664,
-			},
-		},
-		Code: &types.FunctionCodeLocation{
-			Location: aws.String("https://test-bucket.s3.amazonaws.com/test-key"),
-		},
+func WithUpdateAliasOutput(
+	output *lambda.UpdateAliasOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateAliasOutput = output
+	}
+}
+
+func WithUpdateAliasError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateAliasError = err
+	}
+}
+
+func WithDeleteAliasOutput(
+	output *lambda.DeleteAliasOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteAliasOutput = output
+	}
+}
+
+func WithDeleteAliasError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteAliasError = err
+	}
+}
+
+func WithGetAliasOutput(
+	output *lambda.GetAliasOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getAliasOutput = output
+	}
+}
+
+func WithGetAliasError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getAliasError = err
+	}
+}
+
+func WithAddPermissionOutput(
+	output *lambda.AddPermissionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.addPermissionOutput = output
+	}
+}
+
+func WithAddPermissionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.addPermissionError = err
+	}
+}
+
+func WithRemovePermissionOutput(
+	output *lambda.RemovePermissionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.removePermissionOutput = output
+	}
+}
+
+func WithRemovePermissionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.removePermissionError = err
+	}
+}
+
+func WithGetPolicyOutput(
+	output *lambda.GetPolicyOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getPolicyOutput = output
+	}
+}
+
+func WithGetPolicyError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getPolicyError = err
+	}
+}
+
+func WithCreateEventSourceMappingOutput(
+	output *lambda.CreateEventSourceMappingOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createEventSourceMappingOutput = output
+	}
+}
+
+func WithCreateEventSourceMappingError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createEventSourceMappingError = err
+	}
+}
+
+func WithUpdateEventSourceMappingOutput(
+	output *lambda.UpdateEventSourceMappingOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateEventSourceMappingOutput = output
+	}
+}
+
+func WithUpdateEventSourceMappingError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateEventSourceMappingError = err
+	}
+}
+
+func WithDeleteEventSourceMappingOutput(
+	output *lambda.DeleteEventSourceMappingOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteEventSourceMappingOutput = output
+	}
+}
+
+func WithDeleteEventSourceMappingError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteEventSourceMappingError = err
+	}
+}
+
+func WithGetEventSourceMappingOutput(
+	output *lambda.GetEventSourceMappingOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getEventSourceMappingOutput = output
+	}
+}
+
+func WithGetEventSourceMappingError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getEventSourceMappingError = err
+	}
+}
+
+func WithCreateFunctionUrlConfigOutput(
+	output *lambda.CreateFunctionUrlConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createFunctionUrlConfigOutput = output
+	}
+}
+
+func WithCreateFunctionUrlConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createFunctionUrlConfigError = err
+	}
+}
+
+func WithUpdateFunctionUrlConfigOutput(
+	output *lambda.UpdateFunctionUrlConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateFunctionUrlConfigOutput = output
+	}
+}
+
+func WithUpdateFunctionUrlConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateFunctionUrlConfigError = err
+	}
+}
+
+func WithDeleteFunctionUrlConfigOutput(
+	output *lambda.DeleteFunctionUrlConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionUrlConfigOutput = output
+	}
+}
+
+func WithDeleteFunctionUrlConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionUrlConfigError = err
+	}
+}
+
+func WithGetFunctionUrlConfigOutput(
+	output *lambda.GetFunctionUrlConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getFunctionUrlConfigOutput = output
+	}
+}
+
+func WithGetFunctionUrlConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getFunctionUrlConfigError = err
+	}
+}
+
+func WithPublishLayerVersionOutput(
+	output *lambda.PublishLayerVersionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.publishLayerVersionOutput = output
+	}
+}
+
+func WithPublishLayerVersionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.publishLayerVersionError = err
+	}
+}
+
+func WithGetLayerVersionOutput(
+	output *lambda.GetLayerVersionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getLayerVersionOutput = output
+	}
+}
+
+func WithGetLayerVersionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getLayerVersionError = err
+	}
+}
+
+func WithDeleteLayerVersionOutput(
+	output *lambda.DeleteLayerVersionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteLayerVersionOutput = output
+	}
+}
+
+func WithDeleteLayerVersionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteLayerVersionError = err
+	}
+}
+
+func WithAddLayerVersionPermissionOutput(
+	output *lambda.AddLayerVersionPermissionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.addLayerVersionPermissionOutput = output
+	}
+}
+
+func WithAddLayerVersionPermissionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.addLayerVersionPermissionError = err
+	}
+}
+
+func WithRemoveLayerVersionPermissionOutput(
+	output *lambda.RemoveLayerVersionPermissionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.removeLayerVersionPermissionOutput = output
+	}
+}
+
+func WithRemoveLayerVersionPermissionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.removeLayerVersionPermissionError = err
+	}
+}
+
+func WithGetLayerVersionPolicyOutput(
+	output *lambda.GetLayerVersionPolicyOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getLayerVersionPolicyOutput = output
+	}
+}
+
+func WithGetLayerVersionPolicyError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getLayerVersionPolicyError = err
+	}
+}
+
+func WithCreateCodeSigningConfigOutput(
+	output *lambda.CreateCodeSigningConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createCodeSigningConfigOutput = output
+	}
+}
+
+func WithCreateCodeSigningConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.createCodeSigningConfigError = err
+	}
+}
+
+func WithUpdateCodeSigningConfigOutput(
+	output *lambda.UpdateCodeSigningConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateCodeSigningConfigOutput = output
+	}
+}
+
+func WithUpdateCodeSigningConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.updateCodeSigningConfigError = err
+	}
+}
+
+func WithDeleteCodeSigningConfigOutput(
+	output *lambda.DeleteCodeSigningConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteCodeSigningConfigOutput = output
+	}
+}
+
+func WithDeleteCodeSigningConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteCodeSigningConfigError = err
+	}
+}
+
+func WithGetCodeSigningConfigOutput(
+	output *lambda.GetCodeSigningConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getCodeSigningConfigOutput = output
+	}
+}
+
+func WithGetCodeSigningConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getCodeSigningConfigError = err
+	}
+}
+
+func WithListTagsOutput(
+	output *lambda.ListTagsOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listTagsOutput = output
+	}
+}
+
+func WithListTagsError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listTagsError = err
+	}
+}
+
+func WithDeleteFunctionCodeSigningConfigOutput(
+	output *lambda.DeleteFunctionCodeSigningConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionCodeSigningConfigOutput = output
+	}
+}
+
+func WithDeleteFunctionCodeSigningConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionCodeSigningConfigError = err
+	}
+}
+
+func WithPutFunctionEventInvokeConfigOutput(
+	output *lambda.PutFunctionEventInvokeConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.putFunctionEventInvokeConfigOutput = output
+	}
+}
+
+func WithPutFunctionEventInvokeConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.putFunctionEventInvokeConfigError = err
+	}
+}
+
+func WithGetFunctionEventInvokeConfigOutput(
+	output *lambda.GetFunctionEventInvokeConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getFunctionEventInvokeConfigOutput = output
+	}
+}
+
+func WithGetFunctionEventInvokeConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getFunctionEventInvokeConfigError = err
+	}
+}
+
+func WithDeleteFunctionEventInvokeConfigOutput(
+	output *lambda.DeleteFunctionEventInvokeConfigOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionEventInvokeConfigOutput = output
+	}
+}
+
+func WithDeleteFunctionEventInvokeConfigError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionEventInvokeConfigError = err
+	}
+}
+
+func (m *lambdaServiceMock) GetFunction(
+	ctx context.Context,
+	params *lambda.GetFunctionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetFunctionOutput, error) {
+	m.RegisterCall(ctx, params)
+	if len(m.getFunctionOutputSequence) > 0 {
+		output := m.getFunctionOutputSequence[0]
+		m.getFunctionOutputSequence = m.getFunctionOutputSequence[1:]
+		return output, nil
+	}
+	return m.getFunctionOutput, m.getFunctionError
+}
+
+func (m *lambdaServiceMock) GetFunctionCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.GetFunctionCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
+	m.RegisterCall("GetFunctionCodeSigningConfig", ctx, params)
+	return m.getFunctionCodeSigningOutput, m.getFunctionCodeSigningError
+}
+
+func (m *lambdaServiceMock) GetFunctionRecursionConfig(
+	ctx context.Context,
+	params *lambda.GetFunctionRecursionConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetFunctionRecursionConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getFunctionRecursionOutput, m.getFunctionRecursionError
+}
+
+func (m *lambdaServiceMock) GetFunctionConcurrency(
+	ctx context.Context,
+	params *lambda.GetFunctionConcurrencyInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetFunctionConcurrencyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getFunctionConcurrencyOutput, m.getFunctionConcurrencyError
+}
+
+func (m *lambdaServiceMock) DeleteFunction(
+	ctx context.Context,
+	params *lambda.DeleteFunctionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteFunctionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteFunctionOutput, m.deleteFunctionError
+}
+
+func (m *lambdaServiceMock) UpdateFunctionConfiguration(
+	ctx context.Context,
+	params *lambda.UpdateFunctionConfigurationInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UpdateFunctionConfigurationOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.updateFunctionConfigurationOutput, m.updateFunctionConfigurationError
+}
+
+func (m *lambdaServiceMock) UpdateFunctionCode(
+	ctx context.Context,
+	params *lambda.UpdateFunctionCodeInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UpdateFunctionCodeOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.updateFunctionCodeOutput, m.updateFunctionCodeError
+}
+
+func (m *lambdaServiceMock) PutFunctionCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.PutFunctionCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PutFunctionCodeSigningConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putFunctionCodeSigningConfigOutput, m.putFunctionCodeSigningConfigError
+}
+
+func (m *lambdaServiceMock) PutFunctionConcurrency(
+	ctx context.Context,
+	params *lambda.PutFunctionConcurrencyInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PutFunctionConcurrencyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putFunctionConcurrencyOutput, m.putFunctionConcurrencyError
+}
+
+func (m *lambdaServiceMock) PutFunctionRecursionConfig(
+	ctx context.Context,
+	params *lambda.PutFunctionRecursionConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PutFunctionRecursionConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putFunctionRecursionConfigOutput, m.putFunctionRecursionConfigError
+}
+
+func (m *lambdaServiceMock) PutRuntimeManagementConfig(
+	ctx context.Context,
+	params *lambda.PutRuntimeManagementConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PutRuntimeManagementConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putRuntimeManagementConfigOutput, m.putRuntimeManagementConfigError
+}
+
+func (m *lambdaServiceMock) TagResource(
+	ctx context.Context,
+	params *lambda.TagResourceInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.TagResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.tagResourceOutput, m.tagResourceError
+}
+
+func (m *lambdaServiceMock) UntagResource(
+	ctx context.Context,
+	params *lambda.UntagResourceInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UntagResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.untagResourceOutput, m.untagResourceError
+}
+
+func (m *lambdaServiceMock) CreateFunction(
+	ctx context.Context,
+	params *lambda.CreateFunctionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.CreateFunctionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.createFunctionOutput, m.createFunctionError
+}
+
+func (m *lambdaServiceMock) PublishVersion(
+	ctx context.Context,
+	params *lambda.PublishVersionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PublishVersionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.publishVersionOutput, m.publishVersionError
+}
+
+func (m *lambdaServiceMock) ListVersionsByFunction(
+	ctx context.Context,
+	params *lambda.ListVersionsByFunctionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListVersionsByFunctionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.listVersionsByFunctionOutput, m.listVersionsByFunctionError
+}
+
+func (m *lambdaServiceMock) PutProvisionedConcurrencyConfig(
+	ctx context.Context,
+	params *lambda.PutProvisionedConcurrencyConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PutProvisionedConcurrencyConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putProvisionedConcurrencyConfigOutput, m.putProvisionedConcurrencyConfigError
+}
+
+func (m *lambdaServiceMock) GetProvisionedConcurrencyConfig(
+	ctx context.Context,
+	params *lambda.GetProvisionedConcurrencyConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getProvisionedConcurrencyConfigOutput, m.getProvisionedConcurrencyConfigError
+}
+
+func (m *lambdaServiceMock) DeleteProvisionedConcurrencyConfig(
+	ctx context.Context,
+	params *lambda.DeleteProvisionedConcurrencyConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteProvisionedConcurrencyConfigOutput, m.deleteProvisionedConcurrencyConfigError
+}
+
+func (m *lambdaServiceMock) GetRuntimeManagementConfig(
+	ctx context.Context,
+	params *lambda.GetRuntimeManagementConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getRuntimeManagementConfigOutput, m.getRuntimeManagementConfigError
+}
+
+func (m *lambdaServiceMock) CreateAlias(
+	ctx context.Context,
+	params *lambda.CreateAliasInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.CreateAliasOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.createAliasOutput, m.createAliasError
+}
+
+func (m *lambdaServiceMock) UpdateAlias(
+	ctx context.Context,
+	params *lambda.UpdateAliasInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UpdateAliasOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.updateAliasOutput, m.updateAliasError
+}
+
+func (m *lambdaServiceMock) DeleteAlias(
+	ctx context.Context,
+	params *lambda.DeleteAliasInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteAliasOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteAliasOutput, m.deleteAliasError
+}
+
+func (m *lambdaServiceMock) GetAlias(
+	ctx context.Context,
+	params *lambda.GetAliasInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetAliasOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getAliasOutput, m.getAliasError
+}
+
+func (m *lambdaServiceMock) AddPermission(
+	ctx context.Context,
+	params *lambda.AddPermissionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.AddPermissionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.addPermissionOutput, m.addPermissionError
+}
+
+func (m *lambdaServiceMock) RemovePermission(
+	ctx context.Context,
+	params *lambda.RemovePermissionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.RemovePermissionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.removePermissionOutput, m.removePermissionError
+}
+
+func (m *lambdaServiceMock) GetPolicy(
+	ctx context.Context,
+	params *lambda.GetPolicyInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getPolicyOutput, m.getPolicyError
+}
+
+func (m *lambdaServiceMock) CreateEventSourceMapping(
+	ctx context.Context,
+	params *lambda.CreateEventSourceMappingInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.CreateEventSourceMappingOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.createEventSourceMappingOutput, m.createEventSourceMappingError
+}
+
+func (m *lambdaServiceMock) UpdateEventSourceMapping(
+	ctx context.Context,
+	params *lambda.UpdateEventSourceMappingInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UpdateEventSourceMappingOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.updateEventSourceMappingOutput, m.updateEventSourceMappingError
+}
+
+func (m *lambdaServiceMock) DeleteEventSourceMapping(
+	ctx context.Context,
+	params *lambda.DeleteEventSourceMappingInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteEventSourceMappingOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteEventSourceMappingOutput, m.deleteEventSourceMappingError
+}
+
+func (m *lambdaServiceMock) GetEventSourceMapping(
+	ctx context.Context,
+	params *lambda.GetEventSourceMappingInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetEventSourceMappingOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getEventSourceMappingOutput, m.getEventSourceMappingError
+}
+
+func (m *lambdaServiceMock) CreateFunctionUrlConfig(
+	ctx context.Context,
+	params *lambda.CreateFunctionUrlConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.CreateFunctionUrlConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.createFunctionUrlConfigOutput, m.createFunctionUrlConfigError
+}
+
+func (m *lambdaServiceMock) UpdateFunctionUrlConfig(
+	ctx context.Context,
+	params *lambda.UpdateFunctionUrlConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UpdateFunctionUrlConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.updateFunctionUrlConfigOutput, m.updateFunctionUrlConfigError
+}
+
+func (m *lambdaServiceMock) DeleteFunctionUrlConfig(
+	ctx context.Context,
+	params *lambda.DeleteFunctionUrlConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteFunctionUrlConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteFunctionUrlConfigOutput, m.deleteFunctionUrlConfigError
+}
+
+func (m *lambdaServiceMock) GetFunctionUrlConfig(
+	ctx context.Context,
+	params *lambda.GetFunctionUrlConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetFunctionUrlConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getFunctionUrlConfigOutput, m.getFunctionUrlConfigError
+}
+
+func (m *lambdaServiceMock) PublishLayerVersion(
+	ctx context.Context,
+	params *lambda.PublishLayerVersionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PublishLayerVersionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.publishLayerVersionOutput, m.publishLayerVersionError
+}
+
+func (m *lambdaServiceMock) GetLayerVersion(
+	ctx context.Context,
+	params *lambda.GetLayerVersionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetLayerVersionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getLayerVersionOutput, m.getLayerVersionError
+}
+
+func (m *lambdaServiceMock) DeleteLayerVersion(
+	ctx context.Context,
+	params *lambda.DeleteLayerVersionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteLayerVersionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteLayerVersionOutput, m.deleteLayerVersionError
+}
+
+func (m *lambdaServiceMock) AddLayerVersionPermission(
+	ctx context.Context,
+	params *lambda.AddLayerVersionPermissionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.AddLayerVersionPermissionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.addLayerVersionPermissionOutput, m.addLayerVersionPermissionError
+}
+
+func (m *lambdaServiceMock) RemoveLayerVersionPermission(
+	ctx context.Context,
+	params *lambda.RemoveLayerVersionPermissionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.RemoveLayerVersionPermissionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.removeLayerVersionPermissionOutput, m.removeLayerVersionPermissionError
+}
+
+func (m *lambdaServiceMock) GetLayerVersionPolicy(
+	ctx context.Context,
+	params *lambda.GetLayerVersionPolicyInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetLayerVersionPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getLayerVersionPolicyOutput, m.getLayerVersionPolicyError
+}
+
+func (m *lambdaServiceMock) CreateCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.CreateCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.CreateCodeSigningConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.createCodeSigningConfigOutput, m.createCodeSigningConfigError
+}
+
+func (m *lambdaServiceMock) UpdateCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.UpdateCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.UpdateCodeSigningConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.updateCodeSigningConfigOutput, m.updateCodeSigningConfigError
+}
+
+func (m *lambdaServiceMock) DeleteCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.DeleteCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteCodeSigningConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteCodeSigningConfigOutput, m.deleteCodeSigningConfigError
+}
+
+func (m *lambdaServiceMock) GetCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.GetCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetCodeSigningConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getCodeSigningConfigOutput, m.getCodeSigningConfigError
+}
+
+func (m *lambdaServiceMock) ListTags(
+	ctx context.Context,
+	params *lambda.ListTagsInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListTagsOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.listTagsOutput, m.listTagsError
+}
+
+func (m *lambdaServiceMock) DeleteFunctionCodeSigningConfig(
+	ctx context.Context,
+	params *lambda.DeleteFunctionCodeSigningConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteFunctionCodeSigningConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteFunctionCodeSigningConfigOutput, m.deleteFunctionCodeSigningConfigError
+}
+
+func (m *lambdaServiceMock) PutFunctionEventInvokeConfig(
+	ctx context.Context,
+	params *lambda.PutFunctionEventInvokeConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.PutFunctionEventInvokeConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putFunctionEventInvokeConfigOutput, m.putFunctionEventInvokeConfigError
+}
+
+func (m *lambdaServiceMock) GetFunctionEventInvokeConfig(
+	ctx context.Context,
+	params *lambda.GetFunctionEventInvokeConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetFunctionEventInvokeConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getFunctionEventInvokeConfigOutput, m.getFunctionEventInvokeConfigError
+}
+
+func (m *lambdaServiceMock) DeleteFunctionEventInvokeConfig(
+	ctx context.Context,
+	params *lambda.DeleteFunctionEventInvokeConfigInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.DeleteFunctionEventInvokeConfigOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteFunctionEventInvokeConfigOutput, m.deleteFunctionEventInvokeConfigError
+}
+
+func createBaseTestFunctionConfig(
+	functionName string,
+	runtime types.Runtime,
+	handler string,
+	role string,
+) *lambda.GetFunctionOutput {
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			FunctionName: aws.String(functionName),
+			FunctionArn:  aws.String(fmt.Sprintf("arn:aws:lambda:us-east-1:123456789012:function:%s", functionName)),
+			Runtime:      runtime,
+			Handler:      aws.String(handler),
+			Role:         aws.String(role),
+			Architectures: []types.Architecture{
+				types.ArchitectureX8664,
+			},
+		},
+		Code: &types.FunctionCodeLocation{
+			Location: aws.String("https://test-bucket.s3.amazonaws.com/test-key"),
+		},
+	}
+}
+
+type s3ServiceMock struct {
+	plugintestutils.MockCalls
+
+	headObjectOutput *s3sdk.HeadObjectOutput
+	headObjectError  error
+	getObjectOutput  *s3sdk.GetObjectOutput
+	getObjectError   error
+	putObjectOutput  *s3sdk.PutObjectOutput
+	putObjectError   error
+}
+
+type s3ServiceMockOption func(*s3ServiceMock)
+
+func WithHeadObjectOutput(output *s3sdk.HeadObjectOutput) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.headObjectOutput = output
+	}
+}
+
+func WithHeadObjectError(err error) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.headObjectError = err
+	}
+}
+
+func WithGetObjectOutput(output *s3sdk.GetObjectOutput) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.getObjectOutput = output
+	}
+}
+
+func WithGetObjectError(err error) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.getObjectError = err
+	}
+}
+
+func WithPutObjectOutput(output *s3sdk.PutObjectOutput) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.putObjectOutput = output
+	}
+}
+
+func WithPutObjectError(err error) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.putObjectError = err
+	}
+}
+
+func createS3ServiceMock(opts ...s3ServiceMockOption) *s3ServiceMock {
+	mock := &s3ServiceMock{}
+	for _, opt := range opts {
+		opt(mock)
+	}
+	return mock
+}
+
+func (m *s3ServiceMock) HeadObject(
+	ctx context.Context,
+	params *s3sdk.HeadObjectInput,
+	optFns ...func(*s3sdk.Options),
+) (*s3sdk.HeadObjectOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.headObjectOutput, m.headObjectError
+}
+
+func (m *s3ServiceMock) GetObject(
+	ctx context.Context,
+	params *s3sdk.GetObjectInput,
+	optFns ...func(*s3sdk.Options),
+) (*s3sdk.GetObjectOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getObjectOutput, m.getObjectError
+}
+
+func (m *s3ServiceMock) PutObject(
+	ctx context.Context,
+	params *s3sdk.PutObjectInput,
+	optFns ...func(*s3sdk.Options),
+) (*s3sdk.PutObjectOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putObjectOutput, m.putObjectError
+}
+
+type ecrServiceMock struct {
+	plugintestutils.MockCalls
+
+	describeImagesOutput *ecrsdk.DescribeImagesOutput
+	describeImagesError  error
+}
+
+type ecrServiceMockOption func(*ecrServiceMock)
+
+func WithDescribeImagesOutput(output *ecrsdk.DescribeImagesOutput) ecrServiceMockOption {
+	return func(m *ecrServiceMock) {
+		m.describeImagesOutput = output
+	}
+}
+
+func WithDescribeImagesError(err error) ecrServiceMockOption {
+	return func(m *ecrServiceMock) {
+		m.describeImagesError = err
+	}
+}
+
+func createECRServiceMock(opts ...ecrServiceMockOption) *ecrServiceMock {
+	mock := &ecrServiceMock{}
+	for _, opt := range opts {
+		opt(mock)
+	}
+	return mock
+}
+
+func (m *ecrServiceMock) DescribeImages(
+	ctx context.Context,
+	params *ecrsdk.DescribeImagesInput,
+	optFns ...func(*ecrsdk.Options),
+) (*ecrsdk.DescribeImagesOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeImagesOutput, m.describeImagesError
+}
+
+// functionResourceFactory binds the provided S3 and ECR services to the function resource
+// so it can be used with test utilities that expect a resource factory
+// that only accepts a Lambda service factory.
+func functionResourceFactory(
+	s3Service s3.Service,
+	ecrService ecr.Service,
+) func(
+	pluginutils.ServiceFactory[*aws.Config, Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return func(
+		lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+		awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+	) provider.Resource {
+		return FunctionResource(
+			lambdaServiceFactory,
+			func(awsConfig *aws.Config, providerContext provider.Context) s3.Service {
+				return s3Service
+			},
+			func(awsConfig *aws.Config, providerContext provider.Context) ecr.Service {
+				return ecrService
+			},
+			awsConfigStore,
+		)
 	}
 }
@@ -0,0 +1,68 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// AliasResource returns a resource implementation for an AWS Lambda Alias.
+func AliasResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_alias_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_alias_jsonc.md")
+	yamlWeightedExample, _ := examples.ReadFile("examples/resources/lambda_alias_weighted_yaml.md")
+
+	lambdaAliasActions := &lambdaAliasResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/alias",
+		Label:            "AWS Lambda Alias",
+		PlainTextSummary: "A resource for managing an alias for an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define a [Lambda function alias](https://docs.aws.amazon.com/lambda/latest/dg/configuration-aliases.html) " +
+			"that points to one or two versions of a function deployed to AWS. " +
+			"Aliases can split traffic between two versions to enable blue/green and canary deployments.",
+		Schema:  lambdaAliasResourceSchema(),
+		IDField: "aliasArn",
+		// An alias is usually the target used to invoke a function from other resources
+		// such as event source mappings or API gateway integrations,
+		// it is not usually a terminal resource.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+			string(yamlWeightedExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaAliasActions.GetExternalState,
+		CreateFunc:           lambdaAliasActions.Create,
+		UpdateFunc:           lambdaAliasActions.Update,
+		DestroyFunc:          lambdaAliasActions.Destroy,
+		StabilisedFunc:       lambdaAliasActions.Stabilised,
+	}
+}
+
+type lambdaAliasResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaAliasResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
@@ -0,0 +1,120 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaAliasResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&aliasCreate{},
+		&provisionedConcurrencyConfigUpdate{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during alias creation")
+	}
+
+	createAliasOutput, ok := saveOpCtx.Data["createAliasOutput"].(*lambda.CreateAliasOutput)
+	if !ok {
+		return nil, fmt.Errorf("createAliasOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.aliasArn": core.MappingNodeFromString(
+				aws.ToString(createAliasOutput.AliasArn),
+			),
+		},
+	}, nil
+}
+
+func changesToCreateAliasInput(
+	specData *core.MappingNode,
+) (*lambda.CreateAliasInput, bool) {
+	input := &lambda.CreateAliasInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.CreateAliasInput]{
+		pluginutils.NewValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.CreateAliasInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.name",
+			func(value *core.MappingNode, input *lambda.CreateAliasInput) {
+				input.Name = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.functionVersion",
+			func(value *core.MappingNode, input *lambda.CreateAliasInput) {
+				input.FunctionVersion = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.description",
+			func(value *core.MappingNode, input *lambda.CreateAliasInput) {
+				input.Description = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.routingConfig.additionalVersionWeights",
+			func(value *core.MappingNode, input *lambda.CreateAliasInput) {
+				input.RoutingConfig = aliasRoutingConfigFromWeights(value)
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
+
+func aliasRoutingConfigFromWeights(
+	weights *core.MappingNode,
+) *types.AliasRoutingConfiguration {
+	additionalVersionWeights := map[string]float64{}
+	if weights != nil {
+		for version, weight := range weights.Fields {
+			additionalVersionWeights[version] = core.FloatValue(weight)
+		}
+	}
+
+	return &types.AliasRoutingConfiguration{
+		AdditionalVersionWeights: additionalVersionWeights,
+	}
+}
@@ -0,0 +1,53 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type aliasCreate struct {
+	input *lambda.CreateAliasInput
+}
+
+func (u *aliasCreate) Name() string {
+	return "create alias"
+}
+
+func (u *aliasCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToCreateAliasInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *aliasCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	createAliasOutput, err := lambdaService.CreateAlias(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(
+		createAliasOutput.AliasArn,
+	)
+	newSaveOpCtx.Data["createAliasOutput"] = createAliasOutput
+	newSaveOpCtx.Data["functionName"] = aws.ToString(u.input.FunctionName)
+	newSaveOpCtx.Data["qualifier"] = aws.ToString(u.input.Name)
+
+	return newSaveOpCtx, nil
+}
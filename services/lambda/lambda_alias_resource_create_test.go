@@ -0,0 +1,299 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaAliasResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaAliasResourceCreateSuite) Test_create_lambda_alias() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createBasicAliasCreateTestCase(providerCtx, loader),
+		createWeightedAliasWithProvisionedConcurrencyTestCase(providerCtx, loader),
+		createAliasFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		AliasResource,
+		&s.Suite,
+	)
+}
+
+func createBasicAliasCreateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	service := createLambdaServiceMock(
+		WithCreateAliasOutput(&lambda.CreateAliasOutput{
+			AliasArn: aws.String(aliasARN),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+			"description":     core.MappingNodeFromString("Live alias"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create basic alias",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.name",
+					},
+					{
+						FieldPath: "spec.functionVersion",
+					},
+					{
+						FieldPath: "spec.description",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.aliasArn": core.MappingNodeFromString(aliasARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateAlias": &lambda.CreateAliasInput{
+				FunctionName:    aws.String("test-function"),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("3"),
+				Description:     aws.String("Live alias"),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PutProvisionedConcurrencyConfig",
+		},
+	}
+}
+
+func createWeightedAliasWithProvisionedConcurrencyTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	service := createLambdaServiceMock(
+		WithCreateAliasOutput(&lambda.CreateAliasOutput{
+			AliasArn: aws.String(aliasARN),
+		}),
+		WithPutProvisionedConcurrencyConfigOutput(&lambda.PutProvisionedConcurrencyConfigOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+			"routingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"additionalVersionWeights": {
+						Fields: map[string]*core.MappingNode{
+							"4": core.MappingNodeFromFloat(0.1),
+						},
+					},
+				},
+			},
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create weighted alias with provisioned concurrency",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.name",
+					},
+					{
+						FieldPath: "spec.functionVersion",
+					},
+					{
+						FieldPath: "spec.routingConfig.additionalVersionWeights",
+					},
+					{
+						FieldPath: "spec.provisionedConcurrencyConfig.provisionedConcurrentExecutions",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.aliasArn": core.MappingNodeFromString(aliasARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateAlias": &lambda.CreateAliasInput{
+				FunctionName:    aws.String("test-function"),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("3"),
+				RoutingConfig: &types.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{
+						"4": 0.1,
+					},
+				},
+			},
+			"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+				FunctionName:                    aws.String("test-function"),
+				Qualifier:                       aws.String("live"),
+				ProvisionedConcurrentExecutions: aws.Int32(5),
+			},
+		},
+	}
+}
+
+func createAliasFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithCreateAliasError(errors.New("failed to create alias")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create alias failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.name",
+					},
+					{
+						FieldPath: "spec.functionVersion",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaAliasResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaAliasResourceCreateSuite))
+}
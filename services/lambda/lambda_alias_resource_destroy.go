@@ -0,0 +1,37 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaAliasResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceState.SpecData.Fields["functionName"],
+	)
+	aliasName := core.StringValue(
+		input.ResourceState.SpecData.Fields["name"],
+	)
+	// Any provisioned concurrency configuration for the alias
+	// is removed along with the alias.
+	_, err = lambdaService.DeleteAlias(
+		ctx,
+		&lambda.DeleteAliasInput{
+			FunctionName: &functionName,
+			Name:         &aliasName,
+		},
+	)
+
+	return err
+}
@@ -0,0 +1,128 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaAliasResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.CurrentResourceSpec.Fields["functionName"],
+	)
+	aliasName := core.StringValue(
+		input.CurrentResourceSpec.Fields["name"],
+	)
+
+	aliasOutput, err := lambdaService.GetAlias(
+		ctx,
+		&lambda.GetAliasInput{
+			FunctionName: &functionName,
+			Name:         &aliasName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(functionName, aliasOutput)
+
+	l.addOptionalConfigurationsToSpec(aliasOutput, resourceSpecState.Fields)
+
+	err = addProvisionedConcurrencyConfigToSpec(
+		ctx,
+		functionName,
+		aliasName,
+		resourceSpecState.Fields,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add provisioned concurrency config: %w", err)
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaAliasResourceActions) buildBaseResourceSpecState(
+	functionName string,
+	aliasOutput *lambda.GetAliasOutput,
+) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"aliasArn": core.MappingNodeFromString(
+				aws.ToString(aliasOutput.AliasArn),
+			),
+			// The function name is sourced from the current spec as AWS
+			// does not include the function in the alias configuration.
+			"functionName": core.MappingNodeFromString(functionName),
+			"functionVersion": core.MappingNodeFromString(
+				aws.ToString(aliasOutput.FunctionVersion),
+			),
+			"name": core.MappingNodeFromString(
+				aws.ToString(aliasOutput.Name),
+			),
+		},
+	}
+}
+
+func (l *lambdaAliasResourceActions) addOptionalConfigurationsToSpec(
+	aliasOutput *lambda.GetAliasOutput,
+	specFields map[string]*core.MappingNode,
+) {
+	configurations := []optionalConfiguration{
+		{
+			condition: func() bool { return aws.ToString(aliasOutput.Description) != "" },
+			field:     "description",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromString(aws.ToString(aliasOutput.Description))
+			},
+		},
+		{
+			condition: func() bool {
+				return aliasOutput.RoutingConfig != nil &&
+					len(aliasOutput.RoutingConfig.AdditionalVersionWeights) > 0
+			},
+			field: "routingConfig",
+			value: func() *core.MappingNode {
+				return aliasRoutingConfigToMappingNode(aliasOutput.RoutingConfig.AdditionalVersionWeights)
+			},
+		},
+	}
+
+	for _, config := range configurations {
+		if config.condition() {
+			specFields[config.field] = config.value()
+		}
+	}
+}
+
+func aliasRoutingConfigToMappingNode(
+	additionalVersionWeights map[string]float64,
+) *core.MappingNode {
+	weights := make(map[string]*core.MappingNode, len(additionalVersionWeights))
+	for version, weight := range additionalVersionWeights {
+		weights[version] = core.MappingNodeFromFloat(weight)
+	}
+
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"additionalVersionWeights": {
+				Fields: weights,
+			},
+		},
+	}
+}
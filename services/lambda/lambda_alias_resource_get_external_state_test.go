@@ -0,0 +1,198 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaAliasResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaAliasResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createBasicAliasStateTestCase(providerCtx, loader),
+		createWeightedAliasStateTestCase(providerCtx, loader),
+		createGetAliasErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		AliasResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaAliasResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaAliasResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createBasicAliasStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets basic alias state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetAliasOutput(&lambda.GetAliasOutput{
+				AliasArn:        aws.String(aliasARN),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("3"),
+				Description:     aws.String(""),
+			}),
+			WithGetProvisionedConcurrencyConfigError(
+				&types.ProvisionedConcurrencyConfigNotFoundException{
+					Message: aws.String("No Provisioned Concurrency Config found for this function"),
+				},
+			),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"aliasArn":        core.MappingNodeFromString(aliasARN),
+					"functionName":    core.MappingNodeFromString("test-function"),
+					"name":            core.MappingNodeFromString("live"),
+					"functionVersion": core.MappingNodeFromString("2"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"aliasArn":        core.MappingNodeFromString(aliasARN),
+					"functionName":    core.MappingNodeFromString("test-function"),
+					"name":            core.MappingNodeFromString("live"),
+					"functionVersion": core.MappingNodeFromString("3"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createWeightedAliasStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets weighted alias state with provisioned concurrency",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetAliasOutput(&lambda.GetAliasOutput{
+				AliasArn:        aws.String(aliasARN),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("3"),
+				Description:     aws.String("Canary release of v4"),
+				RoutingConfig: &types.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{
+						"4": 0.25,
+					},
+				},
+			}),
+			WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+				RequestedProvisionedConcurrentExecutions: aws.Int32(5),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"aliasArn":     core.MappingNodeFromString(aliasARN),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"name":         core.MappingNodeFromString("live"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"aliasArn":        core.MappingNodeFromString(aliasARN),
+					"functionName":    core.MappingNodeFromString("test-function"),
+					"name":            core.MappingNodeFromString("live"),
+					"functionVersion": core.MappingNodeFromString("3"),
+					"description":     core.MappingNodeFromString("Canary release of v4"),
+					"routingConfig": {
+						Fields: map[string]*core.MappingNode{
+							"additionalVersionWeights": {
+								Fields: map[string]*core.MappingNode{
+									"4": core.MappingNodeFromFloat(0.25),
+								},
+							},
+						},
+					},
+					"provisionedConcurrencyConfig": {
+						Fields: map[string]*core.MappingNode{
+							"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetAliasErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get alias error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetAliasError(errors.New("failed to get alias")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"name":         core.MappingNodeFromString("live"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
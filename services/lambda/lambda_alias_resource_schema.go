@@ -0,0 +1,104 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaAliasResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaAliasDefinition",
+		Description: "The definition of an alias for an AWS Lambda function.",
+		Required:    []string{"functionName", "functionVersion", "name"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function that the alias is for. " +
+					"This can be the function name, the function ARN or a partial ARN.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"name": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name of the alias.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("live"),
+				},
+				// The pattern in the official AWS API documentation for aliases uses
+				// a negative lookahead to prevent alias names that only consist of digits,
+				// which is not supported by Go's regexp engine.
+				// Due to this, numeric alias names will only be rejected by AWS
+				// when the alias is created.
+				Pattern:      "^[a-zA-Z0-9-_]+$",
+				MinLength:    1,
+				MaxLength:    128,
+				MustRecreate: true,
+			},
+			"functionVersion": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The function version that the alias invokes.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("1"),
+					core.MappingNodeFromString("$LATEST"),
+				},
+				Pattern:   "^(\\$LATEST|[0-9]+)$",
+				MinLength: 1,
+				MaxLength: 1024,
+			},
+			"description": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "A description of the alias.",
+				MaxLength:   256,
+			},
+			"routingConfig": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "AliasRoutingConfiguration",
+				Description: "The routing configuration of the alias, used to shift traffic between two versions of a function.",
+				FormattedDescription: "The [routing configuration](https://docs.aws.amazon.com/lambda/latest/dg/configuring-alias-routing.html) " +
+					"of the alias, used to shift traffic between two versions of a function.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"additionalVersionWeights": {
+						Type: provider.ResourceDefinitionsSchemaTypeMap,
+						MapValues: &provider.ResourceDefinitionsSchema{
+							Type:    provider.ResourceDefinitionsSchemaTypeFloat,
+							Minimum: core.ScalarFromFloat(0.0),
+							Maximum: core.ScalarFromFloat(1.0),
+						},
+						Description: "A map of a second function version to the percentage of traffic that is routed to it, " +
+							"expressed as a number between 0.0 and 1.0. The remaining traffic is routed to the primary functionVersion.",
+						FormattedDescription: "A map of a second function version to the percentage of traffic that is routed to it, " +
+							"expressed as a number between `0.0` and `1.0`. The remaining traffic is routed to the primary `functionVersion`.",
+					},
+				},
+			},
+			"provisionedConcurrencyConfig": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "ProvisionedConcurrencyConfig",
+				Description: "The provisioned concurrency configuration for the alias.",
+				FormattedDescription: "The [provisioned concurrency](https://docs.aws.amazon.com/lambda/latest/dg/provisioned-concurrency.html) " +
+					"configuration for the alias.",
+				Required: []string{"provisionedConcurrentExecutions"},
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"provisionedConcurrentExecutions": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The amount of provisioned concurrency to allocate for the alias.",
+						Minimum:     core.ScalarFromInt(1),
+					},
+				},
+			},
+
+			// Computed fields
+			"aliasArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the alias.",
+				Computed:    true,
+			},
+		},
+	}
+}
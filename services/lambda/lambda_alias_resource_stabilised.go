@@ -0,0 +1,60 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaAliasResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// An alias is available as soon as it has been saved,
+	// only provisioned concurrency needs to be allocated before
+	// the alias can be considered stable.
+	if _, hasProvisionedConcurrency := input.ResourceSpec.Fields["provisionedConcurrencyConfig"]; !hasProvisionedConcurrency {
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: true,
+		}, nil
+	}
+
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceSpec.Fields["functionName"],
+	)
+	aliasName := core.StringValue(
+		input.ResourceSpec.Fields["name"],
+	)
+	provisionedConcurrencyOutput, err := lambdaService.GetProvisionedConcurrencyConfig(
+		ctx,
+		&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: &functionName,
+			Qualifier:    &aliasName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if provisionedConcurrencyOutput.Status == types.ProvisionedConcurrencyStatusEnumFailed {
+		return nil, provisionedConcurrencyAllocationError(
+			fmt.Sprintf("alias %q", aliasName),
+			aws.ToString(provisionedConcurrencyOutput.StatusReason),
+		)
+	}
+
+	hasStabilised := provisionedConcurrencyOutput.Status == types.ProvisionedConcurrencyStatusEnumReady
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: hasStabilised,
+	}, nil
+}
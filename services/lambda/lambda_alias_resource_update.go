@@ -0,0 +1,156 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaAliasResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// aliasArn is the ID field that must be present in order to update the resource,
+	// the function name and alias name are used to target the alias
+	// and alias-specific configuration.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	aliasARN, err := core.GetPathValue(
+		"$.aliasArn",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName, err := core.GetPathValue(
+		"$.functionName",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasName, err := core.GetPathValue(
+		"$.name",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&aliasUpdate{},
+		&provisionedConcurrencyConfigUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(aliasARN),
+			Data: map[string]any{
+				"functionName": core.StringValue(functionName),
+				"qualifier":    core.StringValue(aliasName),
+			},
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.aliasArn": aliasARN,
+		},
+	}, nil
+}
+
+func changesToUpdateAliasInput(
+	functionName string,
+	aliasName string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	changes *provider.Changes,
+) (*lambda.UpdateAliasInput, bool) {
+	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
+
+	input := &lambda.UpdateAliasInput{
+		FunctionName: aws.String(functionName),
+		Name:         aws.String(aliasName),
+	}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.UpdateAliasInput]{
+		pluginutils.NewValueSetter(
+			"$.functionVersion",
+			func(value *core.MappingNode, input *lambda.UpdateAliasInput) {
+				input.FunctionVersion = aws.String(core.StringValue(value))
+			},
+			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateAliasInput](true),
+			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateAliasInput](
+				modifiedFields,
+				"spec",
+			),
+		),
+		pluginutils.NewValueSetter(
+			"$.description",
+			func(value *core.MappingNode, input *lambda.UpdateAliasInput) {
+				input.Description = aws.String(core.StringValue(value))
+			},
+			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateAliasInput](true),
+			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateAliasInput](
+				modifiedFields,
+				"spec",
+			),
+		),
+		pluginutils.NewValueSetter(
+			"$.routingConfig.additionalVersionWeights",
+			func(value *core.MappingNode, input *lambda.UpdateAliasInput) {
+				input.RoutingConfig = aliasRoutingConfigFromWeights(value)
+			},
+			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateAliasInput](true),
+			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateAliasInput](
+				modifiedFields,
+				"spec",
+			),
+		),
+	}
+
+	hasUpdates := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasUpdates = hasUpdates || valueSetter.DidSet()
+	}
+
+	// Fields that have been removed from the spec need to be explicitly
+	// cleared for the alias, otherwise AWS will keep the existing values.
+	_, hasDescription := pluginutils.GetValueByPath("$.description", specData)
+	_, hadDescription := pluginutils.GetValueByPath("$.description", currentStateSpecData)
+	if !hasDescription && hadDescription {
+		input.Description = aws.String("")
+		hasUpdates = true
+	}
+
+	weightsPath := "$.routingConfig.additionalVersionWeights"
+	_, hasWeights := pluginutils.GetValueByPath(weightsPath, specData)
+	_, hadWeights := pluginutils.GetValueByPath(weightsPath, currentStateSpecData)
+	if !hasWeights && hadWeights {
+		input.RoutingConfig = aliasRoutingConfigFromWeights(nil)
+		hasUpdates = true
+	}
+
+	return input, hasUpdates
+}
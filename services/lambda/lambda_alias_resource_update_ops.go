@@ -0,0 +1,44 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type aliasUpdate struct {
+	input *lambda.UpdateAliasInput
+}
+
+func (u *aliasUpdate) Name() string {
+	return "alias"
+}
+
+func (u *aliasUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	functionName, aliasName := functionQualifierFromSaveOpContext(saveOpCtx)
+	input, hasUpdates := changesToUpdateAliasInput(
+		functionName,
+		aliasName,
+		specData,
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+		changes,
+	)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *aliasUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.UpdateAlias(ctx, u.input)
+	return saveOpCtx, err
+}
@@ -0,0 +1,357 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaAliasResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaAliasResourceUpdateSuite) Test_update_lambda_alias() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createAliasShiftTrafficUpdateTestCase(providerCtx, loader),
+		createAliasPromoteVersionUpdateTestCase(providerCtx, loader),
+		createAliasUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		AliasResource,
+		&s.Suite,
+	)
+}
+
+func createAliasShiftTrafficUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	service := createLambdaServiceMock(
+		WithUpdateAliasOutput(&lambda.UpdateAliasOutput{
+			AliasArn: aws.String(aliasARN),
+		}),
+		WithPutProvisionedConcurrencyConfigOutput(&lambda.PutProvisionedConcurrencyConfigOutput{}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"aliasArn":        core.MappingNodeFromString(aliasARN),
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+			"routingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"additionalVersionWeights": {
+						Fields: map[string]*core.MappingNode{
+							"4": core.MappingNodeFromFloat(0.1),
+						},
+					},
+				},
+			},
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+			"routingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"additionalVersionWeights": {
+						Fields: map[string]*core.MappingNode{
+							"4": core.MappingNodeFromFloat(0.5),
+						},
+					},
+				},
+			},
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(10),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update alias traffic weights and provisioned concurrency",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-alias-id",
+						Name:       "TestAlias",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.routingConfig.additionalVersionWeights.4",
+					},
+					{
+						FieldPath: "spec.provisionedConcurrencyConfig.provisionedConcurrentExecutions",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.aliasArn": core.MappingNodeFromString(aliasARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateAlias": &lambda.UpdateAliasInput{
+				FunctionName: aws.String("test-function"),
+				Name:         aws.String("live"),
+				RoutingConfig: &types.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{
+						"4": 0.5,
+					},
+				},
+			},
+			"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+				FunctionName:                    aws.String("test-function"),
+				Qualifier:                       aws.String("live"),
+				ProvisionedConcurrentExecutions: aws.Int32(10),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"CreateAlias",
+			"DeleteProvisionedConcurrencyConfig",
+		},
+	}
+}
+
+func createAliasPromoteVersionUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	service := createLambdaServiceMock(
+		WithUpdateAliasOutput(&lambda.UpdateAliasOutput{
+			AliasArn: aws.String(aliasARN),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"aliasArn":        core.MappingNodeFromString(aliasARN),
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+			"description":     core.MappingNodeFromString("Canary release of v4"),
+			"routingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"additionalVersionWeights": {
+						Fields: map[string]*core.MappingNode{
+							"4": core.MappingNodeFromFloat(0.5),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("4"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "promote new version and clear routing config for alias",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-alias-id",
+						Name:       "TestAlias",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionVersion",
+					},
+				},
+				RemovedFields: []string{
+					"spec.description",
+					"spec.routingConfig",
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.aliasArn": core.MappingNodeFromString(aliasARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateAlias": &lambda.UpdateAliasInput{
+				FunctionName:    aws.String("test-function"),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("4"),
+				Description:     aws.String(""),
+				RoutingConfig: &types.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{},
+				},
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PutProvisionedConcurrencyConfig",
+			"DeleteProvisionedConcurrencyConfig",
+		},
+	}
+}
+
+func createAliasUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	aliasARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	service := createLambdaServiceMock(
+		WithUpdateAliasError(errors.New("failed to update alias")),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"aliasArn":        core.MappingNodeFromString(aliasARN),
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("3"),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("4"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update alias failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-alias-id",
+						Name:       "TestAlias",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionVersion",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaAliasResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaAliasResourceUpdateSuite))
+}
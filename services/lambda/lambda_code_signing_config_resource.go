@@ -0,0 +1,68 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// CodeSigningConfigResource returns a resource implementation for an
+// AWS Lambda code signing configuration.
+func CodeSigningConfigResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_code_signing_config_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_code_signing_config_jsonc.md")
+	linkedYAMLExample, _ := examples.ReadFile("examples/resources/lambda_code_signing_config_linked_yaml.md")
+
+	lambdaCodeSigningConfigActions := &lambdaCodeSigningConfigResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/codeSigningConfig",
+		Label:            "AWS Lambda Code Signing Config",
+		PlainTextSummary: "A resource for managing a code signing configuration for AWS Lambda functions.",
+		FormattedDescription: "The resource type used to define a [code signing configuration](https://docs.aws.amazon.com/lambda/latest/dg/configuration-codesigning.html) " +
+			"that defines the trusted publishers of function code and the policy that is applied when the signature checks fail. " +
+			"A function can use a code signing configuration by linking to it from the function resource.",
+		Schema:  lambdaCodeSigningConfigResourceSchema(),
+		IDField: "codeSigningConfigArn",
+		// A code signing configuration is used by functions,
+		// so it is not a terminal resource.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+			string(linkedYAMLExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaCodeSigningConfigActions.GetExternalState,
+		CreateFunc:           lambdaCodeSigningConfigActions.Create,
+		UpdateFunc:           lambdaCodeSigningConfigActions.Update,
+		DestroyFunc:          lambdaCodeSigningConfigActions.Destroy,
+		StabilisedFunc:       lambdaCodeSigningConfigActions.Stabilised,
+	}
+}
+
+type lambdaCodeSigningConfigResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaCodeSigningConfigResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
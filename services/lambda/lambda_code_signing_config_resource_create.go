@@ -0,0 +1,142 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaCodeSigningConfigResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&codeSigningConfigCreate{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during code signing config creation")
+	}
+
+	createOutput, ok := saveOpCtx.Data["createCodeSigningConfigOutput"].(*lambda.CreateCodeSigningConfigOutput)
+	if !ok {
+		return nil, fmt.Errorf("createCodeSigningConfigOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: codeSigningConfigComputedFields(createOutput.CodeSigningConfig),
+	}, nil
+}
+
+func codeSigningConfigComputedFields(
+	codeSigningConfig *types.CodeSigningConfig,
+) map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"spec.codeSigningConfigArn": core.MappingNodeFromString(
+			aws.ToString(codeSigningConfig.CodeSigningConfigArn),
+		),
+		"spec.codeSigningConfigId": core.MappingNodeFromString(
+			aws.ToString(codeSigningConfig.CodeSigningConfigId),
+		),
+	}
+}
+
+func changesToCreateCodeSigningConfigInput(
+	specData *core.MappingNode,
+) (*lambda.CreateCodeSigningConfigInput, bool) {
+	input := &lambda.CreateCodeSigningConfigInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.CreateCodeSigningConfigInput]{
+		pluginutils.NewValueSetter(
+			"$.description",
+			func(value *core.MappingNode, input *lambda.CreateCodeSigningConfigInput) {
+				input.Description = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.allowedPublishers",
+			func(value *core.MappingNode, input *lambda.CreateCodeSigningConfigInput) {
+				input.AllowedPublishers = codeSigningConfigAllowedPublishersFromSpec(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.codeSigningPolicies",
+			func(value *core.MappingNode, input *lambda.CreateCodeSigningConfigInput) {
+				input.CodeSigningPolicies = codeSigningConfigPoliciesFromSpec(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.tags",
+			func(value *core.MappingNode, input *lambda.CreateCodeSigningConfigInput) {
+				input.Tags = tagsFromItems(value.Items)
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
+
+func codeSigningConfigAllowedPublishersFromSpec(
+	allowedPublishers *core.MappingNode,
+) *types.AllowedPublishers {
+	signingProfileVersionARNs, _ := pluginutils.GetValueByPath(
+		"$.signingProfileVersionArns",
+		allowedPublishers,
+	)
+
+	return &types.AllowedPublishers{
+		SigningProfileVersionArns: core.StringSliceValue(signingProfileVersionARNs),
+	}
+}
+
+// When the code signing policies are removed from the spec,
+// the default policy of "Warn" is used.
+func codeSigningConfigPoliciesFromSpec(
+	codeSigningPolicies *core.MappingNode,
+) *types.CodeSigningPolicies {
+	policies := &types.CodeSigningPolicies{
+		UntrustedArtifactOnDeployment: types.CodeSigningPolicyWarn,
+	}
+
+	untrustedArtifactOnDeployment, hasValue := pluginutils.GetValueByPath(
+		"$.untrustedArtifactOnDeployment",
+		codeSigningPolicies,
+	)
+	if hasValue {
+		policies.UntrustedArtifactOnDeployment = types.CodeSigningPolicy(
+			core.StringValue(untrustedArtifactOnDeployment),
+		)
+	}
+
+	return policies
+}
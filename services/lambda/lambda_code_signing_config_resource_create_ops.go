@@ -0,0 +1,49 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type codeSigningConfigCreate struct {
+	input *lambda.CreateCodeSigningConfigInput
+}
+
+func (u *codeSigningConfigCreate) Name() string {
+	return "create code signing config"
+}
+
+func (u *codeSigningConfigCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToCreateCodeSigningConfigInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *codeSigningConfigCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	createOutput, err := lambdaService.CreateCodeSigningConfig(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(createOutput.CodeSigningConfig.CodeSigningConfigArn)
+	newSaveOpCtx.Data["createCodeSigningConfigOutput"] = createOutput
+
+	return newSaveOpCtx, nil
+}
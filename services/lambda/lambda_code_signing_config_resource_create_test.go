@@ -0,0 +1,198 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaCodeSigningConfigResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaCodeSigningConfigResourceCreateSuite) Test_create_lambda_code_signing_config() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createCodeSigningConfigTestCase(providerCtx, loader),
+		createCodeSigningConfigFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		CodeSigningConfigResource,
+		&s.Suite,
+	)
+}
+
+const (
+	testCodeSigningConfigARN = "arn:aws:lambda:us-west-2:123456789012:code-signing-config:csc-0123456789abcdef0"
+	testSigningProfileARN    = "arn:aws:signer:us-west-2:123456789012:/signing-profiles/ReleasePipeline/abcdef1234"
+)
+
+func createCodeSigningConfigTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithCreateCodeSigningConfigOutput(&lambda.CreateCodeSigningConfigOutput{
+			CodeSigningConfig: &types.CodeSigningConfig{
+				CodeSigningConfigArn: aws.String(testCodeSigningConfigARN),
+				CodeSigningConfigId:  aws.String("csc-0123456789abcdef0"),
+			},
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"description": core.MappingNodeFromString("Release pipeline signing"),
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						[]string{testSigningProfileARN},
+					),
+				},
+			},
+			"codeSigningPolicies": {
+				Fields: map[string]*core.MappingNode{
+					"untrustedArtifactOnDeployment": core.MappingNodeFromString("Enforce"),
+				},
+			},
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("team"),
+							"value": core.MappingNodeFromString("platform"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create code signing config",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createCodeSigningConfigDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+				"spec.codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateCodeSigningConfig": &lambda.CreateCodeSigningConfigInput{
+				Description: aws.String("Release pipeline signing"),
+				AllowedPublishers: &types.AllowedPublishers{
+					SigningProfileVersionArns: []string{testSigningProfileARN},
+				},
+				CodeSigningPolicies: &types.CodeSigningPolicies{
+					UntrustedArtifactOnDeployment: types.CodeSigningPolicyEnforce,
+				},
+				Tags: map[string]string{
+					"team": "platform",
+				},
+			},
+		},
+	}
+}
+
+func createCodeSigningConfigFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithCreateCodeSigningConfigError(errors.New("failed to create code signing config")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						[]string{testSigningProfileARN},
+					),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create code signing config failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createCodeSigningConfigDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createCodeSigningConfigDeployInput(
+	specData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	newFields := []provider.FieldChange{}
+	for fieldName := range specData.Fields {
+		newFields = append(newFields, provider.FieldChange{
+			FieldPath: "spec." + fieldName,
+		})
+	}
+
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-code-signing-config-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-code-signing-config-id",
+				ResourceName: "TestCodeSigningConfig",
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/codeSigningConfig",
+					},
+					Spec: specData,
+				},
+			},
+			NewFields: newFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaCodeSigningConfigResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaCodeSigningConfigResourceCreateSuite))
+}
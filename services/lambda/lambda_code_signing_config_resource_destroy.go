@@ -0,0 +1,31 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaCodeSigningConfigResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	codeSigningConfigARN := core.StringValue(
+		input.ResourceState.SpecData.Fields["codeSigningConfigArn"],
+	)
+	_, err = lambdaService.DeleteCodeSigningConfig(
+		ctx,
+		&lambda.DeleteCodeSigningConfigInput{
+			CodeSigningConfigArn: &codeSigningConfigARN,
+		},
+	)
+
+	return err
+}
@@ -0,0 +1,97 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaCodeSigningConfigResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaCodeSigningConfigResourceDestroySuite) Test_destroy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		createCodeSigningConfigDestroyTestCase(
+			"successfully deletes code signing config",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteCodeSigningConfigOutput(&lambda.DeleteCodeSigningConfigOutput{}),
+			),
+			false,
+		),
+		createCodeSigningConfigDestroyTestCase(
+			"fails to delete code signing config",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteCodeSigningConfigError(errors.New("failed to delete code signing config")),
+			),
+			true,
+		),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		CodeSigningConfigResource,
+		&s.Suite,
+	)
+}
+
+func createCodeSigningConfigDestroyTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	service *lambdaServiceMock,
+	expectError bool,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+						"codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+					},
+				},
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
+func TestLambdaCodeSigningConfigResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LambdaCodeSigningConfigResourceDestroySuite))
+}
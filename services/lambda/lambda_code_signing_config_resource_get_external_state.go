@@ -0,0 +1,129 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaCodeSigningConfigResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	codeSigningConfigARN := core.StringValue(
+		input.CurrentResourceSpec.Fields["codeSigningConfigArn"],
+	)
+
+	codeSigningConfigOutput, err := lambdaService.GetCodeSigningConfig(
+		ctx,
+		&lambda.GetCodeSigningConfigInput{
+			CodeSigningConfigArn: &codeSigningConfigARN,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsOutput, err := lambdaService.ListTags(
+		ctx,
+		&lambda.ListTagsInput{
+			Resource: &codeSigningConfigARN,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	codeSigningConfig := codeSigningConfigOutput.CodeSigningConfig
+	resourceSpecState := l.buildBaseResourceSpecState(codeSigningConfig)
+
+	l.addOptionalConfigurationsToSpec(
+		codeSigningConfig,
+		tagsOutput.Tags,
+		resourceSpecState.Fields,
+	)
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaCodeSigningConfigResourceActions) buildBaseResourceSpecState(
+	codeSigningConfig *types.CodeSigningConfig,
+) *core.MappingNode {
+	signingProfileVersionARNs := []string{}
+	if codeSigningConfig.AllowedPublishers != nil {
+		signingProfileVersionARNs = codeSigningConfig.AllowedPublishers.SigningProfileVersionArns
+	}
+
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"codeSigningConfigArn": core.MappingNodeFromString(
+				aws.ToString(codeSigningConfig.CodeSigningConfigArn),
+			),
+			"codeSigningConfigId": core.MappingNodeFromString(
+				aws.ToString(codeSigningConfig.CodeSigningConfigId),
+			),
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						signingProfileVersionARNs,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (l *lambdaCodeSigningConfigResourceActions) addOptionalConfigurationsToSpec(
+	codeSigningConfig *types.CodeSigningConfig,
+	tags map[string]string,
+	specFields map[string]*core.MappingNode,
+) {
+	configurations := []optionalConfiguration{
+		{
+			condition: func() bool { return aws.ToString(codeSigningConfig.Description) != "" },
+			field:     "description",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromString(aws.ToString(codeSigningConfig.Description))
+			},
+		},
+		{
+			condition: func() bool {
+				return codeSigningConfig.CodeSigningPolicies != nil &&
+					codeSigningConfig.CodeSigningPolicies.UntrustedArtifactOnDeployment != ""
+			},
+			field: "codeSigningPolicies",
+			value: func() *core.MappingNode {
+				return &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"untrustedArtifactOnDeployment": core.MappingNodeFromString(
+							string(codeSigningConfig.CodeSigningPolicies.UntrustedArtifactOnDeployment),
+						),
+					},
+				}
+			},
+		},
+		{
+			condition: func() bool { return len(tags) > 0 },
+			field:     "tags",
+			value:     func() *core.MappingNode { return utils.TagsToMappingNode(tags) },
+		},
+	}
+
+	for _, config := range configurations {
+		if config.condition() {
+			specFields[config.field] = config.value()
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaCodeSigningConfigResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaCodeSigningConfigResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createBasicCodeSigningConfigStateTestCase(providerCtx, loader),
+		createCodeSigningConfigWithAllOptionalConfigsStateTestCase(providerCtx, loader),
+		createGetCodeSigningConfigErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		CodeSigningConfigResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaCodeSigningConfigResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaCodeSigningConfigResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createBasicCodeSigningConfigStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets basic code signing config state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetCodeSigningConfigOutput(&lambda.GetCodeSigningConfigOutput{
+				CodeSigningConfig: &types.CodeSigningConfig{
+					CodeSigningConfigArn: aws.String(testCodeSigningConfigARN),
+					CodeSigningConfigId:  aws.String("csc-0123456789abcdef0"),
+					AllowedPublishers: &types.AllowedPublishers{
+						SigningProfileVersionArns: []string{testSigningProfileARN},
+					},
+				},
+			}),
+			WithListTagsOutput(&lambda.ListTagsOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+					"codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+					"allowedPublishers": {
+						Fields: map[string]*core.MappingNode{
+							"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+								[]string{testSigningProfileARN},
+							),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createCodeSigningConfigWithAllOptionalConfigsStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets code signing config state with all optional configurations",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetCodeSigningConfigOutput(&lambda.GetCodeSigningConfigOutput{
+				CodeSigningConfig: &types.CodeSigningConfig{
+					CodeSigningConfigArn: aws.String(testCodeSigningConfigARN),
+					CodeSigningConfigId:  aws.String("csc-0123456789abcdef0"),
+					Description:          aws.String("Release pipeline signing"),
+					AllowedPublishers: &types.AllowedPublishers{
+						SigningProfileVersionArns: []string{testSigningProfileARN},
+					},
+					CodeSigningPolicies: &types.CodeSigningPolicies{
+						UntrustedArtifactOnDeployment: types.CodeSigningPolicyEnforce,
+					},
+				},
+			}),
+			WithListTagsOutput(&lambda.ListTagsOutput{
+				Tags: map[string]string{
+					"team":  "platform",
+					"stage": "production",
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+				},
+			},
+		},
+		CheckTags: true,
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+					"codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+					"description":          core.MappingNodeFromString("Release pipeline signing"),
+					"allowedPublishers": {
+						Fields: map[string]*core.MappingNode{
+							"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+								[]string{testSigningProfileARN},
+							),
+						},
+					},
+					"codeSigningPolicies": {
+						Fields: map[string]*core.MappingNode{
+							"untrustedArtifactOnDeployment": core.MappingNodeFromString("Enforce"),
+						},
+					},
+					"tags": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"key":   core.MappingNodeFromString("stage"),
+									"value": core.MappingNodeFromString("production"),
+								},
+							},
+							{
+								Fields: map[string]*core.MappingNode{
+									"key":   core.MappingNodeFromString("team"),
+									"value": core.MappingNodeFromString("platform"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetCodeSigningConfigErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get code signing config error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetCodeSigningConfigError(errors.New("failed to get code signing config")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
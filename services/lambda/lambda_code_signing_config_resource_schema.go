@@ -0,0 +1,107 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaCodeSigningConfigResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaCodeSigningConfigDefinition",
+		Description: "The definition of a code signing configuration for AWS Lambda functions.",
+		Required:    []string{"allowedPublishers"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"description": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "A description of the code signing configuration.",
+				MaxLength:   256,
+			},
+			"allowedPublishers": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "AllowedPublishers",
+				Description: "The signing profiles for publishers that are trusted to sign function code.",
+				FormattedDescription: "The [signing profiles](https://docs.aws.amazon.com/signer/latest/developerguide/signing-profiles.html) " +
+					"for publishers that are trusted to sign function code.",
+				Required: []string{"signingProfileVersionArns"},
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"signingProfileVersionArns": {
+						Type: provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "The Amazon Resource Names (ARNs) for each of the signing profile versions " +
+							"that are trusted to sign function code, up to 20 signing profile versions can be specified.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+							Examples: []*core.MappingNode{
+								core.MappingNodeFromString(
+									"arn:aws:signer:us-west-2:123456789012:/signing-profiles/MySigningProfile/abcdef1234",
+								),
+							},
+							Pattern: "^arn:(aws[a-zA-Z0-9-]*):([a-zA-Z0-9\\-])+:([a-z]{2}(-gov)?-[a-z]+-\\d{1})?:(\\d{12})?:(.*)$",
+						},
+						MinLength: 1,
+						MaxLength: 20,
+					},
+				},
+			},
+			"codeSigningPolicies": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "CodeSigningPolicies",
+				Description: "The code signing policies that define the actions to take if the validation checks fail.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"untrustedArtifactOnDeployment": {
+						Type: provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The code signing policy that controls the behaviour when the signature validation " +
+							"checks fail for a deployment. When set to Enforce, the deployment is blocked, " +
+							"when set to Warn, the deployment is allowed and a warning is logged.",
+						FormattedDescription: "The code signing policy that controls the behaviour when the signature validation " +
+							"checks fail for a deployment. When set to `Enforce`, the deployment is blocked, " +
+							"when set to `Warn`, the deployment is allowed and a warning is logged.",
+						AllowedValues: []*core.MappingNode{
+							core.MappingNodeFromString("Warn"),
+							core.MappingNodeFromString("Enforce"),
+						},
+						Default: core.MappingNodeFromString("Warn"),
+					},
+				},
+			},
+			"tags": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "A list of tags to apply to the code signing configuration.",
+				FormattedDescription: "A list of [tags](https://docs.aws.amazon.com/lambda/latest/dg/tagging.html) " +
+					"to apply to the code signing configuration.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:        provider.ResourceDefinitionsSchemaTypeObject,
+					Label:       "Tag",
+					Description: "A tag to apply to the code signing configuration.",
+					Required:    []string{"key", "value"},
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"key": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The key of the tag.",
+							MinLength:   1,
+							MaxLength:   128,
+						},
+						"value": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The value of the tag.",
+							MinLength:   0,
+							MaxLength:   256,
+						},
+					},
+				},
+			},
+
+			// Computed fields
+			"codeSigningConfigArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the code signing configuration.",
+				Computed:    true,
+			},
+			"codeSigningConfigId": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The unique identifier for the code signing configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
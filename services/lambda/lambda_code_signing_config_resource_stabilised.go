@@ -0,0 +1,18 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaCodeSigningConfigResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// A code signing configuration can be used by functions as soon as it
+	// has been saved, so it is always considered stable.
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: true,
+	}, nil
+}
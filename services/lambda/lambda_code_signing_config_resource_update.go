@@ -0,0 +1,163 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaCodeSigningConfigResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// codeSigningConfigArn is the ID field that must be present in order to
+	// update the resource, the ID is carried over as it does not change
+	// for the lifetime of the code signing configuration.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	codeSigningConfigARN, err := core.GetPathValue(
+		"$.codeSigningConfigArn",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	codeSigningConfigID, err := core.GetPathValue(
+		"$.codeSigningConfigId",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&codeSigningConfigUpdate{},
+		&codeSigningConfigTagsUpdate{
+			tagsUpdate: tagsUpdate{
+				pathRoot: "$.tags",
+			},
+		},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(codeSigningConfigARN),
+			Data:               map[string]any{},
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.codeSigningConfigArn": codeSigningConfigARN,
+			"spec.codeSigningConfigId":  codeSigningConfigID,
+		},
+	}, nil
+}
+
+func changesToUpdateCodeSigningConfigInput(
+	codeSigningConfigARN string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	changes *provider.Changes,
+) (*lambda.UpdateCodeSigningConfigInput, bool) {
+	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
+
+	input := &lambda.UpdateCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(codeSigningConfigARN),
+	}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.UpdateCodeSigningConfigInput]{
+		newUpdateCodeSigningConfigValueSetter(
+			"$.description",
+			func(value *core.MappingNode, input *lambda.UpdateCodeSigningConfigInput) {
+				input.Description = aws.String(core.StringValue(value))
+			},
+			modifiedFields,
+		),
+		newUpdateCodeSigningConfigValueSetter(
+			"$.allowedPublishers",
+			func(value *core.MappingNode, input *lambda.UpdateCodeSigningConfigInput) {
+				input.AllowedPublishers = codeSigningConfigAllowedPublishersFromSpec(value)
+			},
+			modifiedFields,
+		),
+		newUpdateCodeSigningConfigValueSetter(
+			"$.codeSigningPolicies",
+			func(value *core.MappingNode, input *lambda.UpdateCodeSigningConfigInput) {
+				input.CodeSigningPolicies = codeSigningConfigPoliciesFromSpec(value)
+			},
+			modifiedFields,
+		),
+	}
+
+	hasUpdates := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasUpdates = hasUpdates || valueSetter.DidSet()
+	}
+
+	// Fields that have been removed from the spec need to be explicitly reset,
+	// otherwise AWS will keep the existing values.
+	clearableFields := []struct {
+		path  string
+		clear func(input *lambda.UpdateCodeSigningConfigInput)
+	}{
+		{
+			path: "$.description",
+			clear: func(input *lambda.UpdateCodeSigningConfigInput) {
+				input.Description = aws.String("")
+			},
+		},
+		{
+			path: "$.codeSigningPolicies",
+			clear: func(input *lambda.UpdateCodeSigningConfigInput) {
+				input.CodeSigningPolicies = codeSigningConfigPoliciesFromSpec(nil)
+			},
+		},
+	}
+
+	for _, field := range clearableFields {
+		_, hasValue := pluginutils.GetValueByPath(field.path, specData)
+		_, hadValue := pluginutils.GetValueByPath(field.path, currentStateSpecData)
+		if !hasValue && hadValue {
+			field.clear(input)
+			hasUpdates = true
+		}
+	}
+
+	return input, hasUpdates
+}
+
+func newUpdateCodeSigningConfigValueSetter(
+	path string,
+	setValueFunc func(value *core.MappingNode, input *lambda.UpdateCodeSigningConfigInput),
+	modifiedFields []provider.FieldChange,
+) *pluginutils.ValueSetter[*lambda.UpdateCodeSigningConfigInput] {
+	return pluginutils.NewValueSetter(
+		path,
+		setValueFunc,
+		pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateCodeSigningConfigInput](true),
+		pluginutils.WithValueSetterModifiedFields[*lambda.UpdateCodeSigningConfigInput](
+			modifiedFields,
+			"spec",
+		),
+	)
+}
@@ -0,0 +1,116 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type codeSigningConfigUpdate struct {
+	input *lambda.UpdateCodeSigningConfigInput
+}
+
+func (u *codeSigningConfigUpdate) Name() string {
+	return "code signing config"
+}
+
+func (u *codeSigningConfigUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasUpdates := changesToUpdateCodeSigningConfigInput(
+		saveOpCtx.ProviderUpstreamID,
+		specData,
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+		changes,
+	)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *codeSigningConfigUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.UpdateCodeSigningConfig(ctx, u.input)
+	return saveOpCtx, err
+}
+
+// codeSigningConfigTagsUpdate only applies the tags that have been added,
+// changed or removed for a code signing config, so that updates to other
+// fields do not re-apply the tags that are already set.
+type codeSigningConfigTagsUpdate struct {
+	tagsUpdate
+}
+
+func (u *codeSigningConfigTagsUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	newTagsNode, _ := pluginutils.GetValueByPath(u.pathRoot, specData)
+	currentTagsNode, _ := pluginutils.GetValueByPath(
+		u.pathRoot,
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+	)
+	input, hasUpdates := changesToCodeSigningConfigTagUpdatesInput(
+		saveOpCtx.ProviderUpstreamID,
+		newTagsNode,
+		currentTagsNode,
+	)
+	u.saveTagsInput = input.saveTagsInput
+	u.removeTagsInput = input.removeTagsInput
+	return hasUpdates, saveOpCtx, nil
+}
+
+func changesToCodeSigningConfigTagUpdatesInput(
+	arn string,
+	newTagsNode *core.MappingNode,
+	currentTagsNode *core.MappingNode,
+) (*tagUpdatesInput, bool) {
+	removedTags := []string{}
+	addTags := map[string]string{}
+
+	newTags := tagsFromItems(getItems(newTagsNode))
+	currentTags := tagsFromItems(getItems(currentTagsNode))
+	for key, value := range newTags {
+		currentValue, inCurrentTags := currentTags[key]
+		if !inCurrentTags || currentValue != value {
+			addTags[key] = value
+		}
+	}
+
+	for key := range currentTags {
+		if _, inNewTags := newTags[key]; !inNewTags {
+			removedTags = append(removedTags, key)
+		}
+	}
+
+	return &tagUpdatesInput{
+		saveTagsInput: &lambda.TagResourceInput{
+			Resource: aws.String(arn),
+			Tags:     addTags,
+		},
+		removeTagsInput: &lambda.UntagResourceInput{
+			Resource: aws.String(arn),
+			TagKeys:  removedTags,
+		},
+	}, len(addTags) > 0 || len(removedTags) > 0
+}
+
+func tagsFromItems(items []*core.MappingNode) map[string]string {
+	tags := make(map[string]string, len(items))
+	for _, item := range items {
+		key := core.StringValue(item.Fields["key"])
+		value := core.StringValue(item.Fields["value"])
+		tags[key] = value
+	}
+
+	return tags
+}
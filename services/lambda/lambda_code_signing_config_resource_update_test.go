@@ -0,0 +1,333 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaCodeSigningConfigResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaCodeSigningConfigResourceUpdateSuite) Test_update_lambda_code_signing_config() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createCodeSigningConfigPublishersAndTagsUpdateTestCase(providerCtx, loader),
+		createCodeSigningConfigRemovePoliciesUpdateTestCase(providerCtx, loader),
+		createCodeSigningConfigUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		CodeSigningConfigResource,
+		&s.Suite,
+	)
+}
+
+func createCodeSigningConfigPublishersAndTagsUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	newSigningProfileARN := "arn:aws:signer:us-west-2:123456789012:/signing-profiles/ReleasePipeline/fedcba4321"
+
+	service := createLambdaServiceMock(
+		WithUpdateCodeSigningConfigOutput(&lambda.UpdateCodeSigningConfigOutput{}),
+		WithTagResourceOutput(&lambda.TagResourceOutput{}),
+		WithUntagResourceOutput(&lambda.UntagResourceOutput{}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+			"codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						[]string{testSigningProfileARN},
+					),
+				},
+			},
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("team"),
+							"value": core.MappingNodeFromString("platform"),
+						},
+					},
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("stage"),
+							"value": core.MappingNodeFromString("beta"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						[]string{newSigningProfileARN},
+					),
+				},
+			},
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("team"),
+							"value": core.MappingNodeFromString("security"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update code signing config allowed publishers and tags",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createCodeSigningConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.allowedPublishers.signingProfileVersionArns[0]",
+				},
+				{
+					FieldPath: "spec.tags[0].value",
+				},
+			},
+			[]string{"spec.tags[1]"},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+				"spec.codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateCodeSigningConfig": &lambda.UpdateCodeSigningConfigInput{
+				CodeSigningConfigArn: aws.String(testCodeSigningConfigARN),
+				AllowedPublishers: &types.AllowedPublishers{
+					SigningProfileVersionArns: []string{newSigningProfileARN},
+				},
+			},
+			"TagResource": &lambda.TagResourceInput{
+				Resource: aws.String(testCodeSigningConfigARN),
+				Tags: map[string]string{
+					"team": "security",
+				},
+			},
+			"UntagResource": &lambda.UntagResourceInput{
+				Resource: aws.String(testCodeSigningConfigARN),
+				TagKeys:  []string{"stage"},
+			},
+		},
+	}
+}
+
+func createCodeSigningConfigRemovePoliciesUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithUpdateCodeSigningConfigOutput(&lambda.UpdateCodeSigningConfigOutput{}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+			"codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+			"description":          core.MappingNodeFromString("Release pipeline signing"),
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						[]string{testSigningProfileARN},
+					),
+				},
+			},
+			"codeSigningPolicies": {
+				Fields: map[string]*core.MappingNode{
+					"untrustedArtifactOnDeployment": core.MappingNodeFromString("Enforce"),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"allowedPublishers": {
+				Fields: map[string]*core.MappingNode{
+					"signingProfileVersionArns": core.MappingNodeFromStringSlice(
+						[]string{testSigningProfileARN},
+					),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update code signing config resets removed description and policies",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createCodeSigningConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{},
+			[]string{
+				"spec.description",
+				"spec.codeSigningPolicies",
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+				"spec.codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateCodeSigningConfig": &lambda.UpdateCodeSigningConfigInput{
+				CodeSigningConfigArn: aws.String(testCodeSigningConfigARN),
+				Description:          aws.String(""),
+				CodeSigningPolicies: &types.CodeSigningPolicies{
+					UntrustedArtifactOnDeployment: types.CodeSigningPolicyWarn,
+				},
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"TagResource",
+			"UntagResource",
+		},
+	}
+}
+
+func createCodeSigningConfigUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithUpdateCodeSigningConfigError(errors.New("failed to update code signing config")),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"codeSigningConfigArn": core.MappingNodeFromString(testCodeSigningConfigARN),
+			"codeSigningConfigId":  core.MappingNodeFromString("csc-0123456789abcdef0"),
+			"description":          core.MappingNodeFromString("Release pipeline signing"),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"description": core.MappingNodeFromString("Updated description"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update code signing config failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createCodeSigningConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.description",
+				},
+			},
+			[]string{},
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
+
+func createCodeSigningConfigUpdateInput(
+	currentStateSpecData *core.MappingNode,
+	updatedSpecData *core.MappingNode,
+	modifiedFields []provider.FieldChange,
+	removedFields []string,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-code-signing-config-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-code-signing-config-id",
+				ResourceName: "TestCodeSigningConfig",
+				InstanceID:   "test-instance-id",
+				CurrentResourceState: &state.ResourceState{
+					ResourceID: "test-code-signing-config-id",
+					Name:       "TestCodeSigningConfig",
+					InstanceID: "test-instance-id",
+					SpecData:   currentStateSpecData,
+				},
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/codeSigningConfig",
+					},
+					Spec: updatedSpecData,
+				},
+			},
+			ModifiedFields: modifiedFields,
+			RemovedFields:  removedFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaCodeSigningConfigResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaCodeSigningConfigResourceUpdateSuite))
+}
@@ -0,0 +1,68 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// EventInvokeConfigResource returns a resource implementation for the
+// asynchronous invocation configuration of an AWS Lambda function.
+func EventInvokeConfigResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_event_invoke_config_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_event_invoke_config_jsonc.md")
+
+	lambdaEventInvokeConfigActions := &lambdaEventInvokeConfigResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:  "aws/lambda/eventInvokeConfig",
+		Label: "AWS Lambda Event Invoke Config",
+		PlainTextSummary: "A resource for managing the retry behaviour and destinations " +
+			"for asynchronous invocations of an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define the configuration for " +
+			"[asynchronous invocations](https://docs.aws.amazon.com/lambda/latest/dg/invocation-async.html) " +
+			"of a Lambda function, version or alias deployed to AWS. " +
+			"This includes the number of retries, the maximum age of events and the destinations " +
+			"that records of successful and failed invocations are sent to.",
+		Schema:  lambdaEventInvokeConfigResourceSchema(),
+		IDField: "functionArn",
+		// An event invoke configuration is not usually referenced by other resources.
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaEventInvokeConfigActions.GetExternalState,
+		CreateFunc:           lambdaEventInvokeConfigActions.Create,
+		UpdateFunc:           lambdaEventInvokeConfigActions.Update,
+		DestroyFunc:          lambdaEventInvokeConfigActions.Destroy,
+		StabilisedFunc:       lambdaEventInvokeConfigActions.Stabilised,
+	}
+}
+
+type lambdaEventInvokeConfigResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaEventInvokeConfigResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
@@ -0,0 +1,129 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaEventInvokeConfigResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&eventInvokeConfigCreate{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during event invoke config creation")
+	}
+
+	putOutput, ok := saveOpCtx.Data["putFunctionEventInvokeConfigOutput"].(*lambda.PutFunctionEventInvokeConfigOutput)
+	if !ok {
+		return nil, fmt.Errorf("putFunctionEventInvokeConfigOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.functionArn": core.MappingNodeFromString(
+				aws.ToString(putOutput.FunctionArn),
+			),
+		},
+	}, nil
+}
+
+// changesToPutFunctionEventInvokeConfigInput builds the input for
+// PutFunctionEventInvokeConfig from the full resource spec.
+// Put requests replace the existing configuration, so the same input
+// is used when creating and updating an event invoke config.
+func changesToPutFunctionEventInvokeConfigInput(
+	specData *core.MappingNode,
+) (*lambda.PutFunctionEventInvokeConfigInput, bool) {
+	input := &lambda.PutFunctionEventInvokeConfigInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.PutFunctionEventInvokeConfigInput]{
+		pluginutils.NewValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.PutFunctionEventInvokeConfigInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.qualifier",
+			func(value *core.MappingNode, input *lambda.PutFunctionEventInvokeConfigInput) {
+				input.Qualifier = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.maximumRetryAttempts",
+			func(value *core.MappingNode, input *lambda.PutFunctionEventInvokeConfigInput) {
+				input.MaximumRetryAttempts = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.maximumEventAgeInSeconds",
+			func(value *core.MappingNode, input *lambda.PutFunctionEventInvokeConfigInput) {
+				input.MaximumEventAgeInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.destinationConfig",
+			func(value *core.MappingNode, input *lambda.PutFunctionEventInvokeConfigInput) {
+				input.DestinationConfig = eventInvokeDestinationConfigFromSpec(value)
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
+
+func eventInvokeDestinationConfigFromSpec(value *core.MappingNode) *types.DestinationConfig {
+	destinationConfig := &types.DestinationConfig{}
+	if value == nil {
+		return destinationConfig
+	}
+
+	if onSuccess, ok := value.Fields["onSuccess"]; ok && onSuccess != nil {
+		destinationConfig.OnSuccess = &types.OnSuccess{
+			Destination: aws.String(core.StringValue(onSuccess.Fields["destination"])),
+		}
+	}
+
+	if onFailure, ok := value.Fields["onFailure"]; ok && onFailure != nil {
+		destinationConfig.OnFailure = &types.OnFailure{
+			Destination: aws.String(core.StringValue(onFailure.Fields["destination"])),
+		}
+	}
+
+	return destinationConfig
+}
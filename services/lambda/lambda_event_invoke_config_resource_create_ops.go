@@ -0,0 +1,49 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type eventInvokeConfigCreate struct {
+	input *lambda.PutFunctionEventInvokeConfigInput
+}
+
+func (u *eventInvokeConfigCreate) Name() string {
+	return "create event invoke config"
+}
+
+func (u *eventInvokeConfigCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToPutFunctionEventInvokeConfigInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *eventInvokeConfigCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	putOutput, err := lambdaService.PutFunctionEventInvokeConfig(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(putOutput.FunctionArn)
+	newSaveOpCtx.Data["putFunctionEventInvokeConfigOutput"] = putOutput
+
+	return newSaveOpCtx, nil
+}
@@ -0,0 +1,234 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testEventInvokeConfigFunctionARN  = "arn:aws:lambda:us-west-2:123456789012:function:order-processor:live"
+	testEventInvokeSuccessDestination = "arn:aws:sns:us-west-2:123456789012:order-processed"
+	testEventInvokeFailureDestination = "arn:aws:sqs:us-west-2:123456789012:order-processor-dlq"
+)
+
+type LambdaEventInvokeConfigResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventInvokeConfigResourceCreateSuite) Test_create_lambda_event_invoke_config() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createBasicEventInvokeConfigTestCase(providerCtx, loader),
+		createEventInvokeConfigWithDestinationsTestCase(providerCtx, loader),
+		createEventInvokeConfigFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		EventInvokeConfigResource,
+		&s.Suite,
+	)
+}
+
+func createBasicEventInvokeConfigTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:order-processor:$LATEST"
+
+	service := createLambdaServiceMock(
+		WithPutFunctionEventInvokeConfigOutput(&lambda.PutFunctionEventInvokeConfigOutput{
+			FunctionArn:          aws.String(functionARN),
+			MaximumRetryAttempts: aws.Int32(0),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(0),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create basic event invoke config",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createEventInvokeConfigDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(functionARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutFunctionEventInvokeConfig": &lambda.PutFunctionEventInvokeConfigInput{
+				FunctionName:         aws.String("order-processor"),
+				MaximumRetryAttempts: aws.Int32(0),
+			},
+		},
+	}
+}
+
+func createEventInvokeConfigWithDestinationsTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutFunctionEventInvokeConfigOutput(&lambda.PutFunctionEventInvokeConfigOutput{
+			FunctionArn: aws.String(testEventInvokeConfigFunctionARN),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":             core.MappingNodeFromString("order-processor"),
+			"qualifier":                core.MappingNodeFromString("live"),
+			"maximumRetryAttempts":     core.MappingNodeFromInt(1),
+			"maximumEventAgeInSeconds": core.MappingNodeFromInt(3600),
+			"destinationConfig": {
+				Fields: map[string]*core.MappingNode{
+					"onSuccess": {
+						Fields: map[string]*core.MappingNode{
+							"destination": core.MappingNodeFromString(testEventInvokeSuccessDestination),
+						},
+					},
+					"onFailure": {
+						Fields: map[string]*core.MappingNode{
+							"destination": core.MappingNodeFromString(testEventInvokeFailureDestination),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create event invoke config for an alias with destinations",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createEventInvokeConfigDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutFunctionEventInvokeConfig": &lambda.PutFunctionEventInvokeConfigInput{
+				FunctionName:             aws.String("order-processor"),
+				Qualifier:                aws.String("live"),
+				MaximumRetryAttempts:     aws.Int32(1),
+				MaximumEventAgeInSeconds: aws.Int32(3600),
+				DestinationConfig: &types.DestinationConfig{
+					OnSuccess: &types.OnSuccess{
+						Destination: aws.String(testEventInvokeSuccessDestination),
+					},
+					OnFailure: &types.OnFailure{
+						Destination: aws.String(testEventInvokeFailureDestination),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createEventInvokeConfigFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutFunctionEventInvokeConfigError(errors.New("failed to put event invoke config")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(2),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create event invoke config failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createEventInvokeConfigDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createEventInvokeConfigDeployInput(
+	specData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	newFields := []provider.FieldChange{}
+	for fieldName := range specData.Fields {
+		newFields = append(newFields, provider.FieldChange{
+			FieldPath: "spec." + fieldName,
+		})
+	}
+
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-event-invoke-config-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-event-invoke-config-id",
+				ResourceName: "TestEventInvokeConfig",
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/eventInvokeConfig",
+					},
+					Spec: specData,
+				},
+			},
+			NewFields: newFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaEventInvokeConfigResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaEventInvokeConfigResourceCreateSuite))
+}
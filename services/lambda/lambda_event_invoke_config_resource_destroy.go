@@ -0,0 +1,38 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaEventInvokeConfigResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceState.SpecData.Fields["functionName"],
+	)
+	deleteInput := &lambda.DeleteFunctionEventInvokeConfigInput{
+		FunctionName: &functionName,
+	}
+	qualifier, hasQualifier := pluginutils.GetValueByPath(
+		"$.qualifier",
+		input.ResourceState.SpecData,
+	)
+	if hasQualifier {
+		deleteInput.Qualifier = aws.String(core.StringValue(qualifier))
+	}
+
+	_, err = lambdaService.DeleteFunctionEventInvokeConfig(ctx, deleteInput)
+	return err
+}
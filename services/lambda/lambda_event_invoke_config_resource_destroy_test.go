@@ -0,0 +1,98 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventInvokeConfigResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventInvokeConfigResourceDestroySuite) Test_destroy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		createEventInvokeConfigDestroyTestCase(
+			"successfully deletes event invoke config",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteFunctionEventInvokeConfigOutput(&lambda.DeleteFunctionEventInvokeConfigOutput{}),
+			),
+			false,
+		),
+		createEventInvokeConfigDestroyTestCase(
+			"fails to delete event invoke config",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteFunctionEventInvokeConfigError(errors.New("failed to delete event invoke config")),
+			),
+			true,
+		),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		EventInvokeConfigResource,
+		&s.Suite,
+	)
+}
+
+func createEventInvokeConfigDestroyTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	service *lambdaServiceMock,
+	expectError bool,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"functionName": core.MappingNodeFromString("order-processor"),
+						"qualifier":    core.MappingNodeFromString("live"),
+						"functionArn":  core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:order-processor:live"),
+					},
+				},
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
+func TestLambdaEventInvokeConfigResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LambdaEventInvokeConfigResourceDestroySuite))
+}
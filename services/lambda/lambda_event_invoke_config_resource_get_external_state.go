@@ -0,0 +1,139 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaEventInvokeConfigResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.CurrentResourceSpec.Fields["functionName"],
+	)
+	getInput := &lambda.GetFunctionEventInvokeConfigInput{
+		FunctionName: &functionName,
+	}
+	qualifier, hasQualifier := pluginutils.GetValueByPath(
+		"$.qualifier",
+		input.CurrentResourceSpec,
+	)
+	if hasQualifier {
+		getInput.Qualifier = aws.String(core.StringValue(qualifier))
+	}
+
+	configOutput, err := lambdaService.GetFunctionEventInvokeConfig(ctx, getInput)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn": core.MappingNodeFromString(
+				aws.ToString(configOutput.FunctionArn),
+			),
+			// The function name and qualifier are sourced from the current spec
+			// so that a configuration defined with a function name
+			// or a partial ARN does not report drift.
+			"functionName": core.MappingNodeFromString(functionName),
+		},
+	}
+	if hasQualifier {
+		resourceSpecState.Fields["qualifier"] = qualifier
+	}
+
+	l.addOptionalConfigurationsToSpec(configOutput, resourceSpecState.Fields)
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaEventInvokeConfigResourceActions) addOptionalConfigurationsToSpec(
+	configOutput *lambda.GetFunctionEventInvokeConfigOutput,
+	specFields map[string]*core.MappingNode,
+) {
+	configurations := []optionalConfiguration{
+		{
+			condition: func() bool { return configOutput.MaximumRetryAttempts != nil },
+			field:     "maximumRetryAttempts",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(configOutput.MaximumRetryAttempts)))
+			},
+		},
+		{
+			condition: func() bool { return configOutput.MaximumEventAgeInSeconds != nil },
+			field:     "maximumEventAgeInSeconds",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(configOutput.MaximumEventAgeInSeconds)))
+			},
+		},
+		{
+			condition: func() bool {
+				return configOutput.DestinationConfig != nil &&
+					!eventInvokeDestinationConfigIsEmpty(configOutput.DestinationConfig)
+			},
+			field: "destinationConfig",
+			value: func() *core.MappingNode {
+				return eventInvokeDestinationConfigToMappingNode(configOutput.DestinationConfig)
+			},
+		},
+	}
+
+	for _, config := range configurations {
+		if config.condition() {
+			specFields[config.field] = config.value()
+		}
+	}
+}
+
+func eventInvokeDestinationConfigIsEmpty(destinationConfig *types.DestinationConfig) bool {
+	return (destinationConfig.OnSuccess == nil ||
+		aws.ToString(destinationConfig.OnSuccess.Destination) == "") &&
+		(destinationConfig.OnFailure == nil ||
+			aws.ToString(destinationConfig.OnFailure.Destination) == "")
+}
+
+func eventInvokeDestinationConfigToMappingNode(
+	destinationConfig *types.DestinationConfig,
+) *core.MappingNode {
+	fields := map[string]*core.MappingNode{}
+
+	if destinationConfig.OnSuccess != nil &&
+		aws.ToString(destinationConfig.OnSuccess.Destination) != "" {
+		fields["onSuccess"] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"destination": core.MappingNodeFromString(
+					aws.ToString(destinationConfig.OnSuccess.Destination),
+				),
+			},
+		}
+	}
+
+	if destinationConfig.OnFailure != nil &&
+		aws.ToString(destinationConfig.OnFailure.Destination) != "" {
+		fields["onFailure"] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"destination": core.MappingNodeFromString(
+					aws.ToString(destinationConfig.OnFailure.Destination),
+				),
+			},
+		}
+	}
+
+	return &core.MappingNode{
+		Fields: fields,
+	}
+}
@@ -0,0 +1,186 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventInvokeConfigResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventInvokeConfigResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createBasicEventInvokeConfigStateTestCase(providerCtx, loader),
+		createEventInvokeConfigWithDestinationsStateTestCase(providerCtx, loader),
+		createGetEventInvokeConfigErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		EventInvokeConfigResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaEventInvokeConfigResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaEventInvokeConfigResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createBasicEventInvokeConfigStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:order-processor:$LATEST"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets basic event invoke config state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionEventInvokeConfigOutput(&lambda.GetFunctionEventInvokeConfigOutput{
+				FunctionArn:          aws.String(functionARN),
+				MaximumRetryAttempts: aws.Int32(0),
+				DestinationConfig:    &types.DestinationConfig{},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":          core.MappingNodeFromString(functionARN),
+					"functionName":         core.MappingNodeFromString("order-processor"),
+					"maximumRetryAttempts": core.MappingNodeFromInt(1),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":          core.MappingNodeFromString(functionARN),
+					"functionName":         core.MappingNodeFromString("order-processor"),
+					"maximumRetryAttempts": core.MappingNodeFromInt(0),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createEventInvokeConfigWithDestinationsStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets event invoke config state for an alias with destinations",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionEventInvokeConfigOutput(&lambda.GetFunctionEventInvokeConfigOutput{
+				FunctionArn:              aws.String(testEventInvokeConfigFunctionARN),
+				MaximumRetryAttempts:     aws.Int32(1),
+				MaximumEventAgeInSeconds: aws.Int32(3600),
+				DestinationConfig: &types.DestinationConfig{
+					OnSuccess: &types.OnSuccess{
+						Destination: aws.String(testEventInvokeSuccessDestination),
+					},
+					OnFailure: &types.OnFailure{
+						Destination: aws.String(testEventInvokeFailureDestination),
+					},
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":  core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+					"functionName": core.MappingNodeFromString("order-processor"),
+					"qualifier":    core.MappingNodeFromString("live"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":              core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+					"functionName":             core.MappingNodeFromString("order-processor"),
+					"qualifier":                core.MappingNodeFromString("live"),
+					"maximumRetryAttempts":     core.MappingNodeFromInt(1),
+					"maximumEventAgeInSeconds": core.MappingNodeFromInt(3600),
+					"destinationConfig": {
+						Fields: map[string]*core.MappingNode{
+							"onSuccess": {
+								Fields: map[string]*core.MappingNode{
+									"destination": core.MappingNodeFromString(testEventInvokeSuccessDestination),
+								},
+							},
+							"onFailure": {
+								Fields: map[string]*core.MappingNode{
+									"destination": core.MappingNodeFromString(testEventInvokeFailureDestination),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetEventInvokeConfigErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get event invoke config error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionEventInvokeConfigError(errors.New("failed to get event invoke config")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("order-processor"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
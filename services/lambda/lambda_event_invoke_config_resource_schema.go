@@ -0,0 +1,103 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaEventInvokeConfigResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaEventInvokeConfigDefinition",
+		Description: "The definition of the asynchronous invocation configuration for an AWS Lambda function.",
+		Required:    []string{"functionName"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function that the configuration applies to. " +
+					"This can be the function name, the function ARN or a partial ARN.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"qualifier": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The version number or alias name that the configuration applies to. " +
+					"When omitted, the configuration applies to the unpublished version of the function.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("live"),
+					core.MappingNodeFromString("3"),
+					core.MappingNodeFromString("$LATEST"),
+				},
+				Pattern:      "^[a-zA-Z0-9$_-]+$",
+				MinLength:    1,
+				MaxLength:    128,
+				MustRecreate: true,
+			},
+			"maximumRetryAttempts": {
+				Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The maximum number of times to retry when the function returns an error.",
+				Minimum:     core.ScalarFromInt(0),
+				Maximum:     core.ScalarFromInt(2),
+			},
+			"maximumEventAgeInSeconds": {
+				Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The maximum age of a request that Lambda sends to the function for processing.",
+				Minimum:     core.ScalarFromInt(60),
+				Maximum:     core.ScalarFromInt(21600),
+			},
+			"destinationConfig": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "EventInvokeDestinationConfig",
+				Description: "A destination for events after they have been sent to the function for processing. " +
+					"This can be a Lambda function, Amazon SQS queue, Amazon SNS topic or Amazon EventBridge event bus. " +
+					"Amazon S3 buckets are only supported as on-failure destinations.",
+				FormattedDescription: "A [destination](https://docs.aws.amazon.com/lambda/latest/dg/invocation-async-retain-records.html) " +
+					"for events after they have been sent to the function for processing. " +
+					"This can be a Lambda function, Amazon SQS queue, Amazon SNS topic or Amazon EventBridge event bus. " +
+					"Amazon S3 buckets are only supported as on-failure destinations.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"onSuccess": {
+						Type:        provider.ResourceDefinitionsSchemaTypeObject,
+						Label:       "OnSuccess",
+						Description: "The destination configuration for successful invocations.",
+						Required:    []string{"destination"},
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"destination": eventInvokeDestinationSchema(),
+						},
+					},
+					"onFailure": {
+						Type:        provider.ResourceDefinitionsSchemaTypeObject,
+						Label:       "OnFailure",
+						Description: "The destination configuration for failed invocations.",
+						Required:    []string{"destination"},
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"destination": eventInvokeDestinationSchema(),
+						},
+					},
+				},
+			},
+
+			// Computed fields
+			"functionArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the function that the configuration applies to, " +
+					"including the qualifier if one is set.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func eventInvokeDestinationSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeString,
+		Description: "The Amazon Resource Name (ARN) of the destination resource.",
+		Pattern:     "^$|arn:(aws[a-zA-Z0-9-]*):([a-zA-Z0-9\\-])+:([a-z]{2}(-gov)?-[a-z]+-\\d{1})?:(\\d{12})?:(.*)$",
+		MaxLength:   350,
+	}
+}
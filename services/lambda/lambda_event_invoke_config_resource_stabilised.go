@@ -0,0 +1,18 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaEventInvokeConfigResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// The configuration is applied to asynchronous invocations
+	// as soon as it has been saved, so it is always considered stable.
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: true,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaEventInvokeConfigResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// functionArn is the ID field that must be present in order to update the resource.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	functionARN, err := core.GetPathValue(
+		"$.functionArn",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&eventInvokeConfigUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(functionARN),
+			Data:               map[string]any{},
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.functionArn": functionARN,
+		},
+	}, nil
+}
+
+func changesToUpdateFunctionEventInvokeConfigInput(
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (*lambda.PutFunctionEventInvokeConfigInput, bool) {
+	// The function name and qualifier can not change without recreating the resource,
+	// so any change to the resource spec is a change to the configuration
+	// that needs to be saved.
+	// The full configuration is sent with every update so that fields
+	// removed from the spec are reset to the AWS defaults.
+	hasUpdates := len(changes.ModifiedFields) > 0 ||
+		len(changes.NewFields) > 0 ||
+		len(changes.RemovedFields) > 0
+
+	input, _ := changesToPutFunctionEventInvokeConfigInput(specData)
+	return input, hasUpdates
+}
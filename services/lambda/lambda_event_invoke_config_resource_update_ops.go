@@ -0,0 +1,37 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type eventInvokeConfigUpdate struct {
+	input *lambda.PutFunctionEventInvokeConfigInput
+}
+
+func (u *eventInvokeConfigUpdate) Name() string {
+	return "event invoke config"
+}
+
+func (u *eventInvokeConfigUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasUpdates := changesToUpdateFunctionEventInvokeConfigInput(specData, changes)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *eventInvokeConfigUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.PutFunctionEventInvokeConfig(ctx, u.input)
+	return saveOpCtx, err
+}
@@ -0,0 +1,333 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventInvokeConfigResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventInvokeConfigResourceUpdateSuite) Test_update_lambda_event_invoke_config() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createEventInvokeConfigRetryAndDestinationUpdateTestCase(providerCtx, loader),
+		createEventInvokeConfigRemoveDestinationsUpdateTestCase(providerCtx, loader),
+		createEventInvokeConfigNoChangesUpdateTestCase(providerCtx, loader),
+		createEventInvokeConfigUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		EventInvokeConfigResource,
+		&s.Suite,
+	)
+}
+
+func createEventInvokeConfigRetryAndDestinationUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutFunctionEventInvokeConfigOutput(&lambda.PutFunctionEventInvokeConfigOutput{
+			FunctionArn: aws.String(testEventInvokeConfigFunctionARN),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":          core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"qualifier":            core.MappingNodeFromString("live"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(2),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"qualifier":            core.MappingNodeFromString("live"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(1),
+			"destinationConfig": {
+				Fields: map[string]*core.MappingNode{
+					"onFailure": {
+						Fields: map[string]*core.MappingNode{
+							"destination": core.MappingNodeFromString(testEventInvokeFailureDestination),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event invoke config retry attempts and destinations",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createEventInvokeConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			&provider.Changes{
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.maximumRetryAttempts",
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.destinationConfig",
+					},
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutFunctionEventInvokeConfig": &lambda.PutFunctionEventInvokeConfigInput{
+				FunctionName:         aws.String("order-processor"),
+				Qualifier:            aws.String("live"),
+				MaximumRetryAttempts: aws.Int32(1),
+				DestinationConfig: &types.DestinationConfig{
+					OnFailure: &types.OnFailure{
+						Destination: aws.String(testEventInvokeFailureDestination),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createEventInvokeConfigRemoveDestinationsUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutFunctionEventInvokeConfigOutput(&lambda.PutFunctionEventInvokeConfigOutput{
+			FunctionArn: aws.String(testEventInvokeConfigFunctionARN),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":              core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			"functionName":             core.MappingNodeFromString("order-processor"),
+			"qualifier":                core.MappingNodeFromString("live"),
+			"maximumEventAgeInSeconds": core.MappingNodeFromInt(3600),
+			"destinationConfig": {
+				Fields: map[string]*core.MappingNode{
+					"onSuccess": {
+						Fields: map[string]*core.MappingNode{
+							"destination": core.MappingNodeFromString(testEventInvokeSuccessDestination),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":             core.MappingNodeFromString("order-processor"),
+			"qualifier":                core.MappingNodeFromString("live"),
+			"maximumEventAgeInSeconds": core.MappingNodeFromInt(3600),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event invoke config to remove destinations",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createEventInvokeConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			&provider.Changes{
+				RemovedFields: []string{
+					"spec.destinationConfig",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutFunctionEventInvokeConfig": &lambda.PutFunctionEventInvokeConfigInput{
+				FunctionName:             aws.String("order-processor"),
+				Qualifier:                aws.String("live"),
+				MaximumEventAgeInSeconds: aws.Int32(3600),
+			},
+		},
+	}
+}
+
+func createEventInvokeConfigNoChangesUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock()
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":          core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"qualifier":            core.MappingNodeFromString("live"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(2),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event invoke config with no changes",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createEventInvokeConfigUpdateInput(
+			specData,
+			specData,
+			&provider.Changes{},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PutFunctionEventInvokeConfig",
+		},
+	}
+}
+
+func createEventInvokeConfigUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutFunctionEventInvokeConfigError(errors.New("failed to put event invoke config")),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":          core.MappingNodeFromString(testEventInvokeConfigFunctionARN),
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(2),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":         core.MappingNodeFromString("order-processor"),
+			"maximumRetryAttempts": core.MappingNodeFromInt(0),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event invoke config failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createEventInvokeConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			&provider.Changes{
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.maximumRetryAttempts",
+					},
+				},
+			},
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
+
+func createEventInvokeConfigUpdateInput(
+	currentStateSpecData *core.MappingNode,
+	updatedSpecData *core.MappingNode,
+	changes *provider.Changes,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	changes.AppliedResourceInfo = provider.ResourceInfo{
+		ResourceID:   "test-event-invoke-config-id",
+		ResourceName: "TestEventInvokeConfig",
+		InstanceID:   "test-instance-id",
+		CurrentResourceState: &state.ResourceState{
+			ResourceID: "test-event-invoke-config-id",
+			Name:       "TestEventInvokeConfig",
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		},
+		ResourceWithResolvedSubs: &provider.ResolvedResource{
+			Type: &schema.ResourceTypeWrapper{
+				Value: "aws/lambda/eventInvokeConfig",
+			},
+			Spec: updatedSpecData,
+		},
+	}
+
+	return &provider.ResourceDeployInput{
+		InstanceID:      "test-instance-id",
+		ResourceID:      "test-event-invoke-config-id",
+		Changes:         changes,
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaEventInvokeConfigResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaEventInvokeConfigResourceUpdateSuite))
+}
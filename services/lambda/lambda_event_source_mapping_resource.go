@@ -0,0 +1,67 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// EventSourceMappingResource returns a resource implementation for an AWS Lambda Event Source Mapping.
+func EventSourceMappingResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_jsonc.md")
+	yamlFilteredExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_filtered_yaml.md")
+
+	lambdaEventSourceMappingActions := &lambdaEventSourceMappingResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/eventSourceMapping",
+		Label:            "AWS Lambda Event Source Mapping",
+		PlainTextSummary: "A resource for managing a mapping between an event source and an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define an [event source mapping](https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventsourcemapping.html) " +
+			"that reads from a queue or stream and invokes a Lambda function deployed to AWS. " +
+			"Supported event sources include Amazon SQS, Amazon Kinesis, Amazon DynamoDB Streams, Amazon MSK and Amazon MQ.",
+		Schema:  lambdaEventSourceMappingResourceSchema(),
+		IDField: "id",
+		// An event source mapping connects an event source to a function,
+		// nothing else depends on the mapping itself.
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+			string(yamlFilteredExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaEventSourceMappingActions.GetExternalState,
+		CreateFunc:           lambdaEventSourceMappingActions.Create,
+		UpdateFunc:           lambdaEventSourceMappingActions.Update,
+		DestroyFunc:          lambdaEventSourceMappingActions.Destroy,
+		StabilisedFunc:       lambdaEventSourceMappingActions.Stabilised,
+	}
+}
+
+type lambdaEventSourceMappingResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaEventSourceMappingResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
@@ -0,0 +1,278 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaEventSourceMappingResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&eventSourceMappingCreate{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during event source mapping creation")
+	}
+
+	createOutput, ok := saveOpCtx.Data["createEventSourceMappingOutput"].(*lambda.CreateEventSourceMappingOutput)
+	if !ok {
+		return nil, fmt.Errorf("createEventSourceMappingOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(
+				aws.ToString(createOutput.UUID),
+			),
+			"spec.eventSourceMappingArn": core.MappingNodeFromString(
+				aws.ToString(createOutput.EventSourceMappingArn),
+			),
+		},
+	}, nil
+}
+
+func changesToCreateEventSourceMappingInput(
+	specData *core.MappingNode,
+) (*lambda.CreateEventSourceMappingInput, bool) {
+	input := &lambda.CreateEventSourceMappingInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.CreateEventSourceMappingInput]{
+		pluginutils.NewValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.eventSourceArn",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.EventSourceArn = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.enabled",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.Enabled = aws.Bool(core.BoolValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.batchSize",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.BatchSize = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.maximumBatchingWindowInSeconds",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.MaximumBatchingWindowInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.startingPosition",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.StartingPosition = types.EventSourcePosition(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.startingPositionTimestamp",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.StartingPositionTimestamp = aws.Time(
+					time.Unix(int64(core.IntValue(value)), 0).UTC(),
+				)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.filterCriteria",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.FilterCriteria = eventSourceMappingFilterCriteriaFromSpec(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.destinationConfig",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.DestinationConfig = eventSourceMappingDestinationConfigFromSpec(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.scalingConfig",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.ScalingConfig = eventSourceMappingScalingConfigFromSpec(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.bisectBatchOnFunctionError",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.BisectBatchOnFunctionError = aws.Bool(core.BoolValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.maximumRecordAgeInSeconds",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.MaximumRecordAgeInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.maximumRetryAttempts",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.MaximumRetryAttempts = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.parallelizationFactor",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.ParallelizationFactor = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.tumblingWindowInSeconds",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.TumblingWindowInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.functionResponseTypes",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.FunctionResponseTypes = eventSourceMappingFunctionResponseTypesFromSpec(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.queues",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.Queues = core.StringSliceValue(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.topics",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.Topics = core.StringSliceValue(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.amazonManagedKafkaEventSourceConfig.consumerGroupId",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.AmazonManagedKafkaEventSourceConfig = &types.AmazonManagedKafkaEventSourceConfig{
+					ConsumerGroupId: aws.String(core.StringValue(value)),
+				}
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.sourceAccessConfigurations",
+			func(value *core.MappingNode, input *lambda.CreateEventSourceMappingInput) {
+				input.SourceAccessConfigurations = eventSourceMappingSourceAccessConfigsFromSpec(value)
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
+
+func eventSourceMappingFilterCriteriaFromSpec(
+	value *core.MappingNode,
+) *types.FilterCriteria {
+	filters := []types.Filter{}
+	if value != nil && value.Fields["filters"] != nil {
+		for _, filter := range value.Fields["filters"].Items {
+			filters = append(filters, types.Filter{
+				Pattern: aws.String(core.StringValue(filter.Fields["pattern"])),
+			})
+		}
+	}
+
+	return &types.FilterCriteria{
+		Filters: filters,
+	}
+}
+
+func eventSourceMappingDestinationConfigFromSpec(
+	value *core.MappingNode,
+) *types.DestinationConfig {
+	// An empty destination clears the on-failure destination
+	// for an existing event source mapping.
+	destination := ""
+	if value != nil && value.Fields["onFailure"] != nil {
+		destination = core.StringValue(value.Fields["onFailure"].Fields["destination"])
+	}
+
+	return &types.DestinationConfig{
+		OnFailure: &types.OnFailure{
+			Destination: aws.String(destination),
+		},
+	}
+}
+
+func eventSourceMappingScalingConfigFromSpec(
+	value *core.MappingNode,
+) *types.ScalingConfig {
+	scalingConfig := &types.ScalingConfig{}
+	if value != nil && value.Fields["maximumConcurrency"] != nil {
+		scalingConfig.MaximumConcurrency = aws.Int32(
+			int32(core.IntValue(value.Fields["maximumConcurrency"])),
+		)
+	}
+
+	return scalingConfig
+}
+
+func eventSourceMappingFunctionResponseTypesFromSpec(
+	value *core.MappingNode,
+) []types.FunctionResponseType {
+	responseTypes := []types.FunctionResponseType{}
+	if value != nil {
+		for _, responseType := range core.StringSliceValue(value) {
+			responseTypes = append(responseTypes, types.FunctionResponseType(responseType))
+		}
+	}
+
+	return responseTypes
+}
+
+func eventSourceMappingSourceAccessConfigsFromSpec(
+	value *core.MappingNode,
+) []types.SourceAccessConfiguration {
+	sourceAccessConfigs := []types.SourceAccessConfiguration{}
+	if value != nil {
+		for _, item := range value.Items {
+			sourceAccessConfigs = append(sourceAccessConfigs, types.SourceAccessConfiguration{
+				Type: types.SourceAccessType(core.StringValue(item.Fields["type"])),
+				URI:  aws.String(core.StringValue(item.Fields["uri"])),
+			})
+		}
+	}
+
+	return sourceAccessConfigs
+}
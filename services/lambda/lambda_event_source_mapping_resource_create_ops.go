@@ -0,0 +1,49 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type eventSourceMappingCreate struct {
+	input *lambda.CreateEventSourceMappingInput
+}
+
+func (u *eventSourceMappingCreate) Name() string {
+	return "create event source mapping"
+}
+
+func (u *eventSourceMappingCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToCreateEventSourceMappingInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *eventSourceMappingCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	createOutput, err := lambdaService.CreateEventSourceMapping(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(createOutput.UUID)
+	newSaveOpCtx.Data["createEventSourceMappingOutput"] = createOutput
+
+	return newSaveOpCtx, nil
+}
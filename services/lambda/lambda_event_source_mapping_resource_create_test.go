@@ -0,0 +1,363 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventSourceMappingResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventSourceMappingResourceCreateSuite) Test_create_lambda_event_source_mapping() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createSQSEventSourceMappingTestCase(providerCtx, loader),
+		createKinesisEventSourceMappingTestCase(providerCtx, loader),
+		createEventSourceMappingFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		EventSourceMappingResource,
+		&s.Suite,
+	)
+}
+
+func createSQSEventSourceMappingTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	mappingARN := "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"
+
+	service := createLambdaServiceMock(
+		WithCreateEventSourceMappingOutput(&lambda.CreateEventSourceMappingOutput{
+			UUID:                  aws.String("test-mapping-uuid"),
+			EventSourceMappingArn: aws.String(mappingARN),
+			State:                 aws.String("Creating"),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                   core.MappingNodeFromString("test-function"),
+			"eventSourceArn":                 core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+			"enabled":                        core.MappingNodeFromBool(true),
+			"batchSize":                      core.MappingNodeFromInt(10),
+			"maximumBatchingWindowInSeconds": core.MappingNodeFromInt(5),
+			"functionResponseTypes": core.MappingNodeFromStringSlice(
+				[]string{"ReportBatchItemFailures"},
+			),
+			"scalingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"maximumConcurrency": core.MappingNodeFromInt(20),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create SQS event source mapping",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-mapping-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-mapping-id",
+					ResourceName: "TestEventSourceMapping",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/eventSourceMapping",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.eventSourceArn",
+					},
+					{
+						FieldPath: "spec.enabled",
+					},
+					{
+						FieldPath: "spec.batchSize",
+					},
+					{
+						FieldPath: "spec.maximumBatchingWindowInSeconds",
+					},
+					{
+						FieldPath: "spec.functionResponseTypes",
+					},
+					{
+						FieldPath: "spec.scalingConfig.maximumConcurrency",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id":                    core.MappingNodeFromString("test-mapping-uuid"),
+				"spec.eventSourceMappingArn": core.MappingNodeFromString(mappingARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateEventSourceMapping": &lambda.CreateEventSourceMappingInput{
+				FunctionName:                   aws.String("test-function"),
+				EventSourceArn:                 aws.String("arn:aws:sqs:us-west-2:123456789012:orders"),
+				Enabled:                        aws.Bool(true),
+				BatchSize:                      aws.Int32(10),
+				MaximumBatchingWindowInSeconds: aws.Int32(5),
+				FunctionResponseTypes: []types.FunctionResponseType{
+					types.FunctionResponseTypeReportBatchItemFailures,
+				},
+				ScalingConfig: &types.ScalingConfig{
+					MaximumConcurrency: aws.Int32(20),
+				},
+			},
+		},
+	}
+}
+
+func createKinesisEventSourceMappingTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	mappingARN := "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"
+
+	service := createLambdaServiceMock(
+		WithCreateEventSourceMappingOutput(&lambda.CreateEventSourceMappingOutput{
+			UUID:                  aws.String("test-mapping-uuid"),
+			EventSourceMappingArn: aws.String(mappingARN),
+			State:                 aws.String("Creating"),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":               core.MappingNodeFromString("test-function:live"),
+			"eventSourceArn":             core.MappingNodeFromString("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+			"startingPosition":           core.MappingNodeFromString("AT_TIMESTAMP"),
+			"startingPositionTimestamp":  core.MappingNodeFromInt(1735689600),
+			"parallelizationFactor":      core.MappingNodeFromInt(2),
+			"tumblingWindowInSeconds":    core.MappingNodeFromInt(60),
+			"bisectBatchOnFunctionError": core.MappingNodeFromBool(true),
+			"maximumRetryAttempts":       core.MappingNodeFromInt(3),
+			"maximumRecordAgeInSeconds":  core.MappingNodeFromInt(3600),
+			"filterCriteria": {
+				Fields: map[string]*core.MappingNode{
+					"filters": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"pattern": core.MappingNodeFromString("{\"data\":{\"type\":[\"order_created\"]}}"),
+								},
+							},
+						},
+					},
+				},
+			},
+			"destinationConfig": {
+				Fields: map[string]*core.MappingNode{
+					"onFailure": {
+						Fields: map[string]*core.MappingNode{
+							"destination": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:order-events-dlq"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create Kinesis event source mapping with filtering and failure handling",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-mapping-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-mapping-id",
+					ResourceName: "TestEventSourceMapping",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/eventSourceMapping",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.eventSourceArn",
+					},
+					{
+						FieldPath: "spec.startingPosition",
+					},
+					{
+						FieldPath: "spec.startingPositionTimestamp",
+					},
+					{
+						FieldPath: "spec.parallelizationFactor",
+					},
+					{
+						FieldPath: "spec.tumblingWindowInSeconds",
+					},
+					{
+						FieldPath: "spec.bisectBatchOnFunctionError",
+					},
+					{
+						FieldPath: "spec.maximumRetryAttempts",
+					},
+					{
+						FieldPath: "spec.maximumRecordAgeInSeconds",
+					},
+					{
+						FieldPath: "spec.filterCriteria.filters[0].pattern",
+					},
+					{
+						FieldPath: "spec.destinationConfig.onFailure.destination",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id":                    core.MappingNodeFromString("test-mapping-uuid"),
+				"spec.eventSourceMappingArn": core.MappingNodeFromString(mappingARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateEventSourceMapping": &lambda.CreateEventSourceMappingInput{
+				FunctionName:               aws.String("test-function:live"),
+				EventSourceArn:             aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+				StartingPosition:           types.EventSourcePositionAtTimestamp,
+				StartingPositionTimestamp:  aws.Time(time.Unix(1735689600, 0).UTC()),
+				ParallelizationFactor:      aws.Int32(2),
+				TumblingWindowInSeconds:    aws.Int32(60),
+				BisectBatchOnFunctionError: aws.Bool(true),
+				MaximumRetryAttempts:       aws.Int32(3),
+				MaximumRecordAgeInSeconds:  aws.Int32(3600),
+				FilterCriteria: &types.FilterCriteria{
+					Filters: []types.Filter{
+						{
+							Pattern: aws.String("{\"data\":{\"type\":[\"order_created\"]}}"),
+						},
+					},
+				},
+				DestinationConfig: &types.DestinationConfig{
+					OnFailure: &types.OnFailure{
+						Destination: aws.String("arn:aws:sqs:us-west-2:123456789012:order-events-dlq"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createEventSourceMappingFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithCreateEventSourceMappingError(errors.New("failed to create event source mapping")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":   core.MappingNodeFromString("test-function"),
+			"eventSourceArn": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create event source mapping failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-mapping-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-mapping-id",
+					ResourceName: "TestEventSourceMapping",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/eventSourceMapping",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.eventSourceArn",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaEventSourceMappingResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaEventSourceMappingResourceCreateSuite))
+}
@@ -0,0 +1,98 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventSourceMappingResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventSourceMappingResourceDestroySuite) Test_destroy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		createEventSourceMappingDestroyTestCase(
+			"successfully deletes event source mapping",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteEventSourceMappingOutput(&lambda.DeleteEventSourceMappingOutput{}),
+			),
+			false,
+		),
+		createEventSourceMappingDestroyTestCase(
+			"fails to delete event source mapping",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteEventSourceMappingError(errors.New("failed to delete event source mapping")),
+			),
+			true,
+		),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		EventSourceMappingResource,
+		&s.Suite,
+	)
+}
+
+func createEventSourceMappingDestroyTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	service *lambdaServiceMock,
+	expectError bool,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"id":             core.MappingNodeFromString("test-mapping-uuid"),
+						"functionName":   core.MappingNodeFromString("test-function"),
+						"eventSourceArn": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+					},
+				},
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
+func TestLambdaEventSourceMappingResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LambdaEventSourceMappingResourceDestroySuite))
+}
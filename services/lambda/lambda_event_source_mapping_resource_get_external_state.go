@@ -0,0 +1,326 @@
+package lambda
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaEventSourceMappingResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	mappingID := core.StringValue(
+		input.CurrentResourceSpec.Fields["id"],
+	)
+
+	mappingOutput, err := lambdaService.GetEventSourceMapping(
+		ctx,
+		&lambda.GetEventSourceMappingInput{
+			UUID: &mappingID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(
+		mappingOutput,
+		core.StringValue(input.CurrentResourceSpec.Fields["functionName"]),
+	)
+
+	l.addOptionalConfigurationsToSpec(mappingOutput, resourceSpecState.Fields)
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaEventSourceMappingResourceActions) buildBaseResourceSpecState(
+	mappingOutput *lambda.GetEventSourceMappingOutput,
+	specFunctionName string,
+) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id": core.MappingNodeFromString(
+				aws.ToString(mappingOutput.UUID),
+			),
+			"eventSourceMappingArn": core.MappingNodeFromString(
+				aws.ToString(mappingOutput.EventSourceMappingArn),
+			),
+			"eventSourceArn": core.MappingNodeFromString(
+				aws.ToString(mappingOutput.EventSourceArn),
+			),
+			"functionName": core.MappingNodeFromString(
+				eventSourceMappingFunctionName(
+					aws.ToString(mappingOutput.FunctionArn),
+					specFunctionName,
+				),
+			),
+			"enabled": core.MappingNodeFromBool(
+				eventSourceMappingEnabled(aws.ToString(mappingOutput.State)),
+			),
+		},
+	}
+}
+
+func (l *lambdaEventSourceMappingResourceActions) addOptionalConfigurationsToSpec(
+	mappingOutput *lambda.GetEventSourceMappingOutput,
+	specFields map[string]*core.MappingNode,
+) {
+	configurations := []optionalConfiguration{
+		{
+			condition: func() bool { return mappingOutput.BatchSize != nil },
+			field:     "batchSize",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(mappingOutput.BatchSize)))
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.MaximumBatchingWindowInSeconds != nil },
+			field:     "maximumBatchingWindowInSeconds",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(
+					int(aws.ToInt32(mappingOutput.MaximumBatchingWindowInSeconds)),
+				)
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.StartingPosition != "" },
+			field:     "startingPosition",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromString(string(mappingOutput.StartingPosition))
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.StartingPositionTimestamp != nil },
+			field:     "startingPositionTimestamp",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(
+					int(aws.ToTime(mappingOutput.StartingPositionTimestamp).Unix()),
+				)
+			},
+		},
+		{
+			condition: func() bool {
+				return mappingOutput.FilterCriteria != nil &&
+					len(mappingOutput.FilterCriteria.Filters) > 0
+			},
+			field: "filterCriteria",
+			value: func() *core.MappingNode {
+				return eventSourceMappingFilterCriteriaToMappingNode(mappingOutput.FilterCriteria)
+			},
+		},
+		{
+			condition: func() bool {
+				return mappingOutput.DestinationConfig != nil &&
+					mappingOutput.DestinationConfig.OnFailure != nil &&
+					aws.ToString(mappingOutput.DestinationConfig.OnFailure.Destination) != ""
+			},
+			field: "destinationConfig",
+			value: func() *core.MappingNode {
+				return eventSourceMappingDestinationConfigToMappingNode(mappingOutput.DestinationConfig)
+			},
+		},
+		{
+			condition: func() bool {
+				return mappingOutput.ScalingConfig != nil &&
+					mappingOutput.ScalingConfig.MaximumConcurrency != nil
+			},
+			field: "scalingConfig",
+			value: func() *core.MappingNode {
+				return &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"maximumConcurrency": core.MappingNodeFromInt(
+							int(aws.ToInt32(mappingOutput.ScalingConfig.MaximumConcurrency)),
+						),
+					},
+				}
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.BisectBatchOnFunctionError != nil },
+			field:     "bisectBatchOnFunctionError",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromBool(aws.ToBool(mappingOutput.BisectBatchOnFunctionError))
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.MaximumRecordAgeInSeconds != nil },
+			field:     "maximumRecordAgeInSeconds",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(mappingOutput.MaximumRecordAgeInSeconds)))
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.MaximumRetryAttempts != nil },
+			field:     "maximumRetryAttempts",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(mappingOutput.MaximumRetryAttempts)))
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.ParallelizationFactor != nil },
+			field:     "parallelizationFactor",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(mappingOutput.ParallelizationFactor)))
+			},
+		},
+		{
+			condition: func() bool { return mappingOutput.TumblingWindowInSeconds != nil },
+			field:     "tumblingWindowInSeconds",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromInt(int(aws.ToInt32(mappingOutput.TumblingWindowInSeconds)))
+			},
+		},
+		{
+			condition: func() bool { return len(mappingOutput.FunctionResponseTypes) > 0 },
+			field:     "functionResponseTypes",
+			value: func() *core.MappingNode {
+				responseTypes := make([]string, len(mappingOutput.FunctionResponseTypes))
+				for i, responseType := range mappingOutput.FunctionResponseTypes {
+					responseTypes[i] = string(responseType)
+				}
+				return core.MappingNodeFromStringSlice(responseTypes)
+			},
+		},
+		{
+			condition: func() bool { return len(mappingOutput.Queues) > 0 },
+			field:     "queues",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromStringSlice(mappingOutput.Queues)
+			},
+		},
+		{
+			condition: func() bool { return len(mappingOutput.Topics) > 0 },
+			field:     "topics",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromStringSlice(mappingOutput.Topics)
+			},
+		},
+		{
+			condition: func() bool {
+				return mappingOutput.AmazonManagedKafkaEventSourceConfig != nil &&
+					mappingOutput.AmazonManagedKafkaEventSourceConfig.ConsumerGroupId != nil
+			},
+			field: "amazonManagedKafkaEventSourceConfig",
+			value: func() *core.MappingNode {
+				return &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"consumerGroupId": core.MappingNodeFromString(
+							aws.ToString(mappingOutput.AmazonManagedKafkaEventSourceConfig.ConsumerGroupId),
+						),
+					},
+				}
+			},
+		},
+		{
+			condition: func() bool { return len(mappingOutput.SourceAccessConfigurations) > 0 },
+			field:     "sourceAccessConfigurations",
+			value: func() *core.MappingNode {
+				return eventSourceMappingSourceAccessConfigsToMappingNode(
+					mappingOutput.SourceAccessConfigurations,
+				)
+			},
+		},
+	}
+
+	for _, config := range configurations {
+		if config.condition() {
+			specFields[config.field] = config.value()
+		}
+	}
+}
+
+// The function ARN returned by AWS is only used in place of the function
+// name from the spec when it refers to a different function,
+// this prevents drift from being reported for mappings defined with
+// a function name, partial ARN or qualified function name.
+func eventSourceMappingFunctionName(functionARN string, specFunctionName string) string {
+	if specFunctionName == "" || functionARN == specFunctionName {
+		return functionARN
+	}
+
+	if strings.HasSuffix(functionARN, ":function:"+specFunctionName) {
+		return specFunctionName
+	}
+
+	isPartialARN := strings.Contains(specFunctionName, ":function:")
+	if isPartialARN && strings.HasSuffix(functionARN, ":"+specFunctionName) {
+		return specFunctionName
+	}
+
+	return functionARN
+}
+
+// A mapping that is still being created or updated is considered
+// to be enabled as the enabled state is only known once the mapping
+// has settled.
+func eventSourceMappingEnabled(state string) bool {
+	return state != "Disabled" && state != "Disabling"
+}
+
+func eventSourceMappingFilterCriteriaToMappingNode(
+	filterCriteria *types.FilterCriteria,
+) *core.MappingNode {
+	filters := make([]*core.MappingNode, len(filterCriteria.Filters))
+	for i, filter := range filterCriteria.Filters {
+		filters[i] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"pattern": core.MappingNodeFromString(aws.ToString(filter.Pattern)),
+			},
+		}
+	}
+
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"filters": {
+				Items: filters,
+			},
+		},
+	}
+}
+
+func eventSourceMappingDestinationConfigToMappingNode(
+	destinationConfig *types.DestinationConfig,
+) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"onFailure": {
+				Fields: map[string]*core.MappingNode{
+					"destination": core.MappingNodeFromString(
+						aws.ToString(destinationConfig.OnFailure.Destination),
+					),
+				},
+			},
+		},
+	}
+}
+
+func eventSourceMappingSourceAccessConfigsToMappingNode(
+	sourceAccessConfigs []types.SourceAccessConfiguration,
+) *core.MappingNode {
+	items := make([]*core.MappingNode, len(sourceAccessConfigs))
+	for i, sourceAccessConfig := range sourceAccessConfigs {
+		items[i] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"type": core.MappingNodeFromString(string(sourceAccessConfig.Type)),
+				"uri":  core.MappingNodeFromString(aws.ToString(sourceAccessConfig.URI)),
+			},
+		}
+	}
+
+	return &core.MappingNode{
+		Items: items,
+	}
+}
@@ -0,0 +1,234 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventSourceMappingResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventSourceMappingResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createSQSEventSourceMappingStateTestCase(providerCtx, loader),
+		createKinesisEventSourceMappingStateTestCase(providerCtx, loader),
+		createGetEventSourceMappingErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		EventSourceMappingResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaEventSourceMappingResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaEventSourceMappingResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createSQSEventSourceMappingStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	mappingARN := "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets SQS event source mapping state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+				UUID:                           aws.String("test-mapping-uuid"),
+				EventSourceMappingArn:          aws.String(mappingARN),
+				EventSourceArn:                 aws.String("arn:aws:sqs:us-west-2:123456789012:orders"),
+				FunctionArn:                    aws.String("arn:aws:lambda:us-west-2:123456789012:function:test-function"),
+				State:                          aws.String("Disabled"),
+				BatchSize:                      aws.Int32(10),
+				MaximumBatchingWindowInSeconds: aws.Int32(5),
+				FunctionResponseTypes: []types.FunctionResponseType{
+					types.FunctionResponseTypeReportBatchItemFailures,
+				},
+				ScalingConfig: &types.ScalingConfig{
+					MaximumConcurrency: aws.Int32(20),
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":             core.MappingNodeFromString("test-mapping-uuid"),
+					"functionName":   core.MappingNodeFromString("test-function"),
+					"eventSourceArn": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":                             core.MappingNodeFromString("test-mapping-uuid"),
+					"eventSourceMappingArn":          core.MappingNodeFromString(mappingARN),
+					"functionName":                   core.MappingNodeFromString("test-function"),
+					"eventSourceArn":                 core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+					"enabled":                        core.MappingNodeFromBool(false),
+					"batchSize":                      core.MappingNodeFromInt(10),
+					"maximumBatchingWindowInSeconds": core.MappingNodeFromInt(5),
+					"functionResponseTypes": core.MappingNodeFromStringSlice(
+						[]string{"ReportBatchItemFailures"},
+					),
+					"scalingConfig": {
+						Fields: map[string]*core.MappingNode{
+							"maximumConcurrency": core.MappingNodeFromInt(20),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createKinesisEventSourceMappingStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	mappingARN := "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"
+	otherFunctionARN := "arn:aws:lambda:us-west-2:123456789012:function:other-function"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets Kinesis event source mapping state that targets another function",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+				UUID:                       aws.String("test-mapping-uuid"),
+				EventSourceMappingArn:      aws.String(mappingARN),
+				EventSourceArn:             aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+				FunctionArn:                aws.String(otherFunctionARN),
+				State:                      aws.String("Enabled"),
+				StartingPosition:           types.EventSourcePositionAtTimestamp,
+				StartingPositionTimestamp:  aws.Time(time.Unix(1735689600, 0)),
+				BisectBatchOnFunctionError: aws.Bool(true),
+				MaximumRetryAttempts:       aws.Int32(3),
+				FilterCriteria: &types.FilterCriteria{
+					Filters: []types.Filter{
+						{
+							Pattern: aws.String("{\"data\":{\"type\":[\"order_created\"]}}"),
+						},
+					},
+				},
+				DestinationConfig: &types.DestinationConfig{
+					OnFailure: &types.OnFailure{
+						Destination: aws.String("arn:aws:sqs:us-west-2:123456789012:order-events-dlq"),
+					},
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":             core.MappingNodeFromString("test-mapping-uuid"),
+					"functionName":   core.MappingNodeFromString("test-function"),
+					"eventSourceArn": core.MappingNodeFromString("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":                         core.MappingNodeFromString("test-mapping-uuid"),
+					"eventSourceMappingArn":      core.MappingNodeFromString(mappingARN),
+					"functionName":               core.MappingNodeFromString(otherFunctionARN),
+					"eventSourceArn":             core.MappingNodeFromString("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+					"enabled":                    core.MappingNodeFromBool(true),
+					"startingPosition":           core.MappingNodeFromString("AT_TIMESTAMP"),
+					"startingPositionTimestamp":  core.MappingNodeFromInt(1735689600),
+					"bisectBatchOnFunctionError": core.MappingNodeFromBool(true),
+					"maximumRetryAttempts":       core.MappingNodeFromInt(3),
+					"filterCriteria": {
+						Fields: map[string]*core.MappingNode{
+							"filters": {
+								Items: []*core.MappingNode{
+									{
+										Fields: map[string]*core.MappingNode{
+											"pattern": core.MappingNodeFromString("{\"data\":{\"type\":[\"order_created\"]}}"),
+										},
+									},
+								},
+							},
+						},
+					},
+					"destinationConfig": {
+						Fields: map[string]*core.MappingNode{
+							"onFailure": {
+								Fields: map[string]*core.MappingNode{
+									"destination": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:order-events-dlq"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetEventSourceMappingErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get event source mapping error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetEventSourceMappingError(errors.New("failed to get event source mapping")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id": core.MappingNodeFromString("test-mapping-uuid"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,282 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaEventSourceMappingResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaEventSourceMappingDefinition",
+		Description: "The definition of an event source mapping for an AWS Lambda function.",
+		Required:    []string{"functionName", "eventSourceArn"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function that records from the event source are sent to. " +
+					"This can be the function name, the function ARN, a partial ARN or a qualified function name " +
+					"that targets a version or alias.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("MyFunction:live"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength: 1,
+				MaxLength: 140,
+			},
+			"eventSourceArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the event source. " +
+					"This can be an Amazon SQS queue, Amazon Kinesis stream, Amazon DynamoDB stream, " +
+					"Amazon MSK cluster or Amazon MQ broker.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:my-queue"),
+					core.MappingNodeFromString("arn:aws:kinesis:us-west-2:123456789012:stream/my-stream"),
+				},
+				Pattern:      "^arn:(aws[a-zA-Z0-9-]*):([a-zA-Z0-9\\-])+:([a-z]{2}(-gov)?-[a-z]+-\\d{1})?:(\\d{12})?:(.*)$",
+				MustRecreate: true,
+			},
+			"enabled": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether the event source mapping is active. " +
+					"When disabled, Lambda stops polling the event source.",
+				Default: core.MappingNodeFromBool(true),
+			},
+			"batchSize": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The maximum number of records in each batch that Lambda pulls from the event source " +
+					"and sends to the function.",
+				FormattedDescription: "The maximum number of records in each batch that Lambda pulls from the event source " +
+					"and sends to the function. See the [batching behaviour](https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventsourcemapping.html#invocation-eventsourcemapping-batching) " +
+					"documentation for the defaults and limits for each type of event source.",
+				Minimum: core.ScalarFromInt(1),
+				Maximum: core.ScalarFromInt(10000),
+			},
+			"maximumBatchingWindowInSeconds": {
+				Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The maximum amount of time, in seconds, that Lambda spends gathering records before invoking the function.",
+				Minimum:     core.ScalarFromInt(0),
+				Maximum:     core.ScalarFromInt(300),
+			},
+			"startingPosition": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The position in a stream from which to start reading. " +
+					"Required for Amazon Kinesis, Amazon DynamoDB and Amazon MSK event sources.",
+				FormattedDescription: "The position in a stream from which to start reading. " +
+					"Required for Amazon Kinesis, Amazon DynamoDB and Amazon MSK event sources. " +
+					"`AT_TIMESTAMP` is only supported for Amazon Kinesis streams and Amazon MSK.",
+				AllowedValues: []*core.MappingNode{
+					core.MappingNodeFromString("TRIM_HORIZON"),
+					core.MappingNodeFromString("LATEST"),
+					core.MappingNodeFromString("AT_TIMESTAMP"),
+				},
+				MustRecreate: true,
+			},
+			"startingPositionTimestamp": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The time from which to start reading, expressed as a Unix timestamp in seconds. " +
+					"Only used when startingPosition is set to AT_TIMESTAMP.",
+				FormattedDescription: "The time from which to start reading, expressed as a Unix timestamp in seconds. " +
+					"Only used when `startingPosition` is set to `AT_TIMESTAMP`.",
+				MustRecreate: true,
+			},
+			"filterCriteria": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "FilterCriteria",
+				Description: "An object that defines the filter criteria that determine whether Lambda should process an event.",
+				FormattedDescription: "An object that defines the [filter criteria](https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html) " +
+					"that determine whether Lambda should process an event.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"filters": {
+						Type:        provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "A list of filters, an event is processed if it matches any of the filters.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type:        provider.ResourceDefinitionsSchemaTypeObject,
+							Label:       "Filter",
+							Description: "A structure within a filter criteria object that defines an event filtering pattern.",
+							Required:    []string{"pattern"},
+							Attributes: map[string]*provider.ResourceDefinitionsSchema{
+								"pattern": {
+									Type:        provider.ResourceDefinitionsSchemaTypeString,
+									Description: "A filter pattern expressed as a JSON string.",
+									Examples: []*core.MappingNode{
+										core.MappingNodeFromString("{\"body\":{\"type\":[\"order_created\"]}}"),
+									},
+									MaxLength: 4096,
+								},
+							},
+						},
+					},
+				},
+			},
+			"destinationConfig": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "EventSourceMappingDestinationConfig",
+				Description: "A configuration object that specifies the destination for discarded batches of records. " +
+					"Supported for Amazon Kinesis, Amazon DynamoDB and Kafka event sources.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"onFailure": {
+						Type:        provider.ResourceDefinitionsSchemaTypeObject,
+						Label:       "OnFailure",
+						Description: "The destination configuration for failed invocations.",
+						Required:    []string{"destination"},
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"destination": {
+								Type: provider.ResourceDefinitionsSchemaTypeString,
+								Description: "The Amazon Resource Name (ARN) of the destination resource. " +
+									"This can be an Amazon SQS queue, Amazon SNS topic or Amazon S3 bucket.",
+								Pattern:   "^$|arn:(aws[a-zA-Z0-9-]*):([a-zA-Z0-9\\-])+:([a-z]{2}(-gov)?-[a-z]+-\\d{1})?:(\\d{12})?:(.*)$",
+								MaxLength: 350,
+							},
+						},
+					},
+				},
+			},
+			"scalingConfig": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "ScalingConfig",
+				Description: "The scaling configuration for the event source, only supported for Amazon SQS event sources.",
+				FormattedDescription: "The [scaling configuration](https://docs.aws.amazon.com/lambda/latest/dg/with-sqs.html#events-sqs-max-concurrency) " +
+					"for the event source, only supported for Amazon SQS event sources.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"maximumConcurrency": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The maximum number of concurrent function instances that the Amazon SQS event source can invoke.",
+						Minimum:     core.ScalarFromInt(2),
+						Maximum:     core.ScalarFromInt(1000),
+					},
+				},
+			},
+			"bisectBatchOnFunctionError": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "If the function returns an error, split the batch in two and retry. " +
+					"Only supported for Amazon Kinesis and Amazon DynamoDB stream event sources.",
+			},
+			"maximumRecordAgeInSeconds": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "Discard records older than the specified age. The default value is infinite (-1). " +
+					"Only supported for Amazon Kinesis and Amazon DynamoDB stream event sources.",
+				Minimum: core.ScalarFromInt(-1),
+				Maximum: core.ScalarFromInt(604800),
+			},
+			"maximumRetryAttempts": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "Discard records after the specified number of retries. The default value is infinite (-1). " +
+					"Only supported for Amazon Kinesis and Amazon DynamoDB stream event sources.",
+				Minimum: core.ScalarFromInt(-1),
+				Maximum: core.ScalarFromInt(10000),
+			},
+			"parallelizationFactor": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The number of batches to process from each shard concurrently. " +
+					"Only supported for Amazon Kinesis and Amazon DynamoDB stream event sources.",
+				Minimum: core.ScalarFromInt(1),
+				Maximum: core.ScalarFromInt(10),
+			},
+			"tumblingWindowInSeconds": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The duration in seconds of a processing window for Amazon Kinesis and Amazon DynamoDB stream event sources. " +
+					"A value of 0 seconds indicates no tumbling window.",
+				FormattedDescription: "The duration in seconds of a [processing window](https://docs.aws.amazon.com/lambda/latest/dg/services-kinesis-windows.html) " +
+					"for Amazon Kinesis and Amazon DynamoDB stream event sources. A value of `0` seconds indicates no tumbling window.",
+				Minimum: core.ScalarFromInt(0),
+				Maximum: core.ScalarFromInt(900),
+			},
+			"functionResponseTypes": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "A list of current response type enums applied to the event source mapping. " +
+					"Used to enable partial batch responses for stream and queue event sources.",
+				FormattedDescription: "A list of current response type enums applied to the event source mapping. " +
+					"Set to `[\"ReportBatchItemFailures\"]` to enable [partial batch responses](https://docs.aws.amazon.com/lambda/latest/dg/services-sqs-errorhandling.html#services-sqs-batchfailurereporting).",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+					AllowedValues: []*core.MappingNode{
+						core.MappingNodeFromString("ReportBatchItemFailures"),
+					},
+				},
+			},
+			"queues": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "The name of the Amazon MQ broker destination queue to consume.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:      provider.ResourceDefinitionsSchemaTypeString,
+					MinLength: 1,
+					MaxLength: 1000,
+				},
+				MustRecreate: true,
+			},
+			"topics": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "The name of the Kafka topic to consume.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:      provider.ResourceDefinitionsSchemaTypeString,
+					MinLength: 1,
+					MaxLength: 249,
+				},
+				MustRecreate: true,
+			},
+			"amazonManagedKafkaEventSourceConfig": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "AmazonManagedKafkaEventSourceConfig",
+				Description: "Specific configuration settings for an Amazon MSK event source.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"consumerGroupId": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The identifier for the Kafka consumer group to join.",
+						Pattern:     "^[a-zA-Z0-9-\\/*:_+=.@-]*$",
+						MinLength:   1,
+						MaxLength:   200,
+					},
+				},
+				MustRecreate: true,
+			},
+			"sourceAccessConfigurations": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "An array of authentication protocols or VPC components required to secure the event source. " +
+					"Used for Amazon MQ brokers and Kafka clusters.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:        provider.ResourceDefinitionsSchemaTypeObject,
+					Label:       "SourceAccessConfiguration",
+					Description: "An authentication protocol or VPC component that is required to secure the event source.",
+					Required:    []string{"type", "uri"},
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"type": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The type of authentication protocol, VPC component or virtual host for the event source.",
+							AllowedValues: []*core.MappingNode{
+								core.MappingNodeFromString("BASIC_AUTH"),
+								core.MappingNodeFromString("VPC_SUBNET"),
+								core.MappingNodeFromString("VPC_SECURITY_GROUP"),
+								core.MappingNodeFromString("SASL_SCRAM_512_AUTH"),
+								core.MappingNodeFromString("SASL_SCRAM_256_AUTH"),
+								core.MappingNodeFromString("VIRTUAL_HOST"),
+								core.MappingNodeFromString("CLIENT_CERTIFICATE_TLS_AUTH"),
+								core.MappingNodeFromString("SERVER_ROOT_CA_CERTIFICATE"),
+							},
+						},
+						"uri": {
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The value for the chosen configuration type, " +
+								"for example the ARN of a Secrets Manager secret or a subnet ID.",
+							MinLength: 1,
+							MaxLength: 200,
+						},
+					},
+				},
+			},
+
+			// Computed fields
+			"id": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The unique identifier (UUID) of the event source mapping.",
+				Computed:    true,
+			},
+			"eventSourceMappingArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the event source mapping.",
+				Computed:    true,
+			},
+		},
+	}
+}
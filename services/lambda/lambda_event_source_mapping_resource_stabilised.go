@@ -0,0 +1,51 @@
+package lambda
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+// Event source mapping states that indicate the mapping is still
+// transitioning to the state defined in the spec.
+// Disabling is included as a mapping that is being disabled
+// has not yet reached its desired state.
+var eventSourceMappingTransitionalStates = []string{
+	"Creating",
+	"Updating",
+	"Enabling",
+	"Disabling",
+}
+
+func (l *lambdaEventSourceMappingResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	mappingID := core.StringValue(
+		input.ResourceSpec.Fields["id"],
+	)
+	mappingOutput, err := lambdaService.GetEventSourceMapping(
+		ctx,
+		&lambda.GetEventSourceMappingInput{
+			UUID: &mappingID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mappingState := aws.ToString(mappingOutput.State)
+	hasStabilised := !slices.Contains(eventSourceMappingTransitionalStates, mappingState)
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: hasStabilised,
+	}, nil
+}
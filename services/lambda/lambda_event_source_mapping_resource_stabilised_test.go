@@ -0,0 +1,174 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventSourceMappingResourceStabilisedSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventSourceMappingResourceStabilisedSuite) Test_stabilised() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	mappingSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id":             core.MappingNodeFromString("test-mapping-uuid"),
+			"functionName":   core.MappingNodeFromString("test-function"),
+			"eventSourceArn": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+		},
+	}
+
+	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, Service]{
+		{
+			Name: "returns stabilised when the mapping is enabled",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+					UUID:  aws.String("test-mapping-uuid"),
+					State: aws.String("Enabled"),
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    mappingSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: true,
+			},
+		},
+		{
+			Name: "returns stabilised when the mapping is disabled",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+					UUID:  aws.String("test-mapping-uuid"),
+					State: aws.String("Disabled"),
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    mappingSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: true,
+			},
+		},
+		{
+			Name: "returns not stabilised when the mapping is being created",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+					UUID:  aws.String("test-mapping-uuid"),
+					State: aws.String("Creating"),
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    mappingSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+		},
+		{
+			Name: "returns not stabilised when the mapping is being updated",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+					UUID:  aws.String("test-mapping-uuid"),
+					State: aws.String("Updating"),
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    mappingSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+		},
+		{
+			Name: "returns not stabilised when the mapping is being enabled",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+					UUID:  aws.String("test-mapping-uuid"),
+					State: aws.String("Enabling"),
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    mappingSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+		},
+		{
+			Name: "fails when the mapping cannot be retrieved",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetEventSourceMappingError(errors.New("failed to get event source mapping")),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    mappingSpec,
+			},
+			ExpectError: true,
+		},
+	}
+
+	plugintestutils.RunResourceHasStabilisedTestCases(
+		testCases,
+		EventSourceMappingResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaEventSourceMappingResourceStabilisedSuite(t *testing.T) {
+	suite.Run(t, new(LambdaEventSourceMappingResourceStabilisedSuite))
+}
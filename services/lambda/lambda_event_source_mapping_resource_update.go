@@ -0,0 +1,248 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaEventSourceMappingResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// id is the ID field that must be present in order to update the resource,
+	// the event source mapping ARN is carried over as it does not change
+	// for the lifetime of the mapping.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	mappingID, err := core.GetPathValue(
+		"$.id",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mappingARN, err := core.GetPathValue(
+		"$.eventSourceMappingArn",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&eventSourceMappingUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(mappingID),
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id":                    mappingID,
+			"spec.eventSourceMappingArn": mappingARN,
+		},
+	}, nil
+}
+
+func changesToUpdateEventSourceMappingInput(
+	mappingID string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	changes *provider.Changes,
+) (*lambda.UpdateEventSourceMappingInput, bool) {
+	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
+
+	input := &lambda.UpdateEventSourceMappingInput{
+		UUID: aws.String(mappingID),
+	}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.UpdateEventSourceMappingInput]{
+		newUpdateEventSourceMappingValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.enabled",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.Enabled = aws.Bool(core.BoolValue(value))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.batchSize",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.BatchSize = aws.Int32(int32(core.IntValue(value)))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.maximumBatchingWindowInSeconds",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.MaximumBatchingWindowInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.filterCriteria",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.FilterCriteria = eventSourceMappingFilterCriteriaFromSpec(value)
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.destinationConfig",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.DestinationConfig = eventSourceMappingDestinationConfigFromSpec(value)
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.scalingConfig",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.ScalingConfig = eventSourceMappingScalingConfigFromSpec(value)
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.bisectBatchOnFunctionError",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.BisectBatchOnFunctionError = aws.Bool(core.BoolValue(value))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.maximumRecordAgeInSeconds",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.MaximumRecordAgeInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.maximumRetryAttempts",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.MaximumRetryAttempts = aws.Int32(int32(core.IntValue(value)))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.parallelizationFactor",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.ParallelizationFactor = aws.Int32(int32(core.IntValue(value)))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.tumblingWindowInSeconds",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.TumblingWindowInSeconds = aws.Int32(int32(core.IntValue(value)))
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.functionResponseTypes",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.FunctionResponseTypes = eventSourceMappingFunctionResponseTypesFromSpec(value)
+			},
+			modifiedFields,
+		),
+		newUpdateEventSourceMappingValueSetter(
+			"$.sourceAccessConfigurations",
+			func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput) {
+				input.SourceAccessConfigurations = eventSourceMappingSourceAccessConfigsFromSpec(value)
+			},
+			modifiedFields,
+		),
+	}
+
+	hasUpdates := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasUpdates = hasUpdates || valueSetter.DidSet()
+	}
+
+	// Configuration objects and lists that have been removed from the spec
+	// need to be explicitly cleared, otherwise AWS will keep the existing values.
+	// Numeric settings that are removed are left as they are as AWS does not
+	// provide a way to reset them to the defaults for the event source.
+	clearableFields := []struct {
+		path  string
+		clear func(input *lambda.UpdateEventSourceMappingInput)
+	}{
+		{
+			path: "$.filterCriteria",
+			clear: func(input *lambda.UpdateEventSourceMappingInput) {
+				input.FilterCriteria = eventSourceMappingFilterCriteriaFromSpec(nil)
+			},
+		},
+		{
+			path: "$.destinationConfig",
+			clear: func(input *lambda.UpdateEventSourceMappingInput) {
+				input.DestinationConfig = eventSourceMappingDestinationConfigFromSpec(nil)
+			},
+		},
+		{
+			path: "$.scalingConfig",
+			clear: func(input *lambda.UpdateEventSourceMappingInput) {
+				input.ScalingConfig = eventSourceMappingScalingConfigFromSpec(nil)
+			},
+		},
+		{
+			path: "$.functionResponseTypes",
+			clear: func(input *lambda.UpdateEventSourceMappingInput) {
+				input.FunctionResponseTypes = eventSourceMappingFunctionResponseTypesFromSpec(nil)
+			},
+		},
+	}
+
+	for _, field := range clearableFields {
+		_, hasValue := pluginutils.GetValueByPath(field.path, specData)
+		_, hadValue := pluginutils.GetValueByPath(field.path, currentStateSpecData)
+		if !hasValue && hadValue {
+			field.clear(input)
+			hasUpdates = true
+		}
+	}
+
+	return input, hasUpdates
+}
+
+func newUpdateEventSourceMappingValueSetter(
+	path string,
+	setValueFunc func(value *core.MappingNode, input *lambda.UpdateEventSourceMappingInput),
+	modifiedFields []provider.FieldChange,
+) *pluginutils.ValueSetter[*lambda.UpdateEventSourceMappingInput] {
+	return pluginutils.NewValueSetter(
+		path,
+		setValueFunc,
+		pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateEventSourceMappingInput](true),
+		pluginutils.WithValueSetterModifiedFields[*lambda.UpdateEventSourceMappingInput](
+			modifiedFields,
+			"spec",
+		),
+	)
+}
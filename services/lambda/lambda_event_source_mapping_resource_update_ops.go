@@ -0,0 +1,42 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type eventSourceMappingUpdate struct {
+	input *lambda.UpdateEventSourceMappingInput
+}
+
+func (u *eventSourceMappingUpdate) Name() string {
+	return "event source mapping"
+}
+
+func (u *eventSourceMappingUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasUpdates := changesToUpdateEventSourceMappingInput(
+		saveOpCtx.ProviderUpstreamID,
+		specData,
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+		changes,
+	)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *eventSourceMappingUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.UpdateEventSourceMapping(ctx, u.input)
+	return saveOpCtx, err
+}
@@ -0,0 +1,342 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaEventSourceMappingResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaEventSourceMappingResourceUpdateSuite) Test_update_lambda_event_source_mapping() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createEventSourceMappingBatchingUpdateTestCase(providerCtx, loader),
+		createEventSourceMappingRemoveConfigUpdateTestCase(providerCtx, loader),
+		createEventSourceMappingUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		EventSourceMappingResource,
+		&s.Suite,
+	)
+}
+
+func createEventSourceMappingBatchingUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	mappingARN := "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"
+
+	service := createLambdaServiceMock(
+		WithUpdateEventSourceMappingOutput(&lambda.UpdateEventSourceMappingOutput{
+			UUID:  aws.String("test-mapping-uuid"),
+			State: aws.String("Updating"),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id":                    core.MappingNodeFromString("test-mapping-uuid"),
+			"eventSourceMappingArn": core.MappingNodeFromString(mappingARN),
+			"functionName":          core.MappingNodeFromString("test-function"),
+			"eventSourceArn":        core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+			"enabled":               core.MappingNodeFromBool(true),
+			"batchSize":             core.MappingNodeFromInt(10),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                   core.MappingNodeFromString("test-function"),
+			"eventSourceArn":                 core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+			"enabled":                        core.MappingNodeFromBool(false),
+			"batchSize":                      core.MappingNodeFromInt(50),
+			"maximumBatchingWindowInSeconds": core.MappingNodeFromInt(10),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event source mapping batching and disable the mapping",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-mapping-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-mapping-id",
+					ResourceName: "TestEventSourceMapping",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-mapping-id",
+						Name:       "TestEventSourceMapping",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/eventSourceMapping",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.enabled",
+					},
+					{
+						FieldPath: "spec.batchSize",
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.maximumBatchingWindowInSeconds",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id":                    core.MappingNodeFromString("test-mapping-uuid"),
+				"spec.eventSourceMappingArn": core.MappingNodeFromString(mappingARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateEventSourceMapping": &lambda.UpdateEventSourceMappingInput{
+				UUID:                           aws.String("test-mapping-uuid"),
+				Enabled:                        aws.Bool(false),
+				BatchSize:                      aws.Int32(50),
+				MaximumBatchingWindowInSeconds: aws.Int32(10),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"CreateEventSourceMapping",
+		},
+	}
+}
+
+func createEventSourceMappingRemoveConfigUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	mappingARN := "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"
+
+	service := createLambdaServiceMock(
+		WithUpdateEventSourceMappingOutput(&lambda.UpdateEventSourceMappingOutput{
+			UUID:  aws.String("test-mapping-uuid"),
+			State: aws.String("Updating"),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id":                    core.MappingNodeFromString("test-mapping-uuid"),
+			"eventSourceMappingArn": core.MappingNodeFromString(mappingARN),
+			"functionName":          core.MappingNodeFromString("test-function"),
+			"eventSourceArn":        core.MappingNodeFromString("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+			"startingPosition":      core.MappingNodeFromString("LATEST"),
+			"filterCriteria": {
+				Fields: map[string]*core.MappingNode{
+					"filters": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"pattern": core.MappingNodeFromString("{\"data\":{\"type\":[\"order_created\"]}}"),
+								},
+							},
+						},
+					},
+				},
+			},
+			"destinationConfig": {
+				Fields: map[string]*core.MappingNode{
+					"onFailure": {
+						Fields: map[string]*core.MappingNode{
+							"destination": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:order-events-dlq"),
+						},
+					},
+				},
+			},
+			"functionResponseTypes": core.MappingNodeFromStringSlice(
+				[]string{"ReportBatchItemFailures"},
+			),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":     core.MappingNodeFromString("test-function"),
+			"eventSourceArn":   core.MappingNodeFromString("arn:aws:kinesis:us-west-2:123456789012:stream/order-events"),
+			"startingPosition": core.MappingNodeFromString("LATEST"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event source mapping to clear removed configuration",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-mapping-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-mapping-id",
+					ResourceName: "TestEventSourceMapping",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-mapping-id",
+						Name:       "TestEventSourceMapping",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/eventSourceMapping",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				RemovedFields: []string{
+					"spec.filterCriteria",
+					"spec.destinationConfig",
+					"spec.functionResponseTypes",
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id":                    core.MappingNodeFromString("test-mapping-uuid"),
+				"spec.eventSourceMappingArn": core.MappingNodeFromString(mappingARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateEventSourceMapping": &lambda.UpdateEventSourceMappingInput{
+				UUID: aws.String("test-mapping-uuid"),
+				FilterCriteria: &types.FilterCriteria{
+					Filters: []types.Filter{},
+				},
+				DestinationConfig: &types.DestinationConfig{
+					OnFailure: &types.OnFailure{
+						Destination: aws.String(""),
+					},
+				},
+				FunctionResponseTypes: []types.FunctionResponseType{},
+			},
+		},
+	}
+}
+
+func createEventSourceMappingUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithUpdateEventSourceMappingError(errors.New("failed to update event source mapping")),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id":                    core.MappingNodeFromString("test-mapping-uuid"),
+			"eventSourceMappingArn": core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:event-source-mapping:test-mapping-uuid"),
+			"functionName":          core.MappingNodeFromString("test-function"),
+			"eventSourceArn":        core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+			"batchSize":             core.MappingNodeFromInt(10),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":   core.MappingNodeFromString("test-function"),
+			"eventSourceArn": core.MappingNodeFromString("arn:aws:sqs:us-west-2:123456789012:orders"),
+			"batchSize":      core.MappingNodeFromInt(20),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update event source mapping failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-mapping-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-mapping-id",
+					ResourceName: "TestEventSourceMapping",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-mapping-id",
+						Name:       "TestEventSourceMapping",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/eventSourceMapping",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.batchSize",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaEventSourceMappingResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaEventSourceMappingResourceUpdateSuite))
+}
@@ -0,0 +1,604 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/newstack-cloud/celerity-provider-aws/services/s3"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+const (
+	// The maximum size of a zip archive that can be uploaded directly
+	// to the Lambda API when creating or updating a function.
+	// Larger deployment packages must be uploaded to Amazon S3 first.
+	functionCodeDirectUploadMaxSize = 50 * 1024 * 1024
+	// The prefix for the keys of deployment packages staged in Amazon S3
+	// by the provider.
+	functionCodeStagingKeyPrefix = "celerity/lambda"
+)
+
+// functionCodePackage holds a deployment package built by the provider
+// from local source files along with the SHA-256 hash of the package
+// in the same base64-encoded format that Lambda reports as the
+// CodeSha256 of a function.
+// When the package has been staged in Amazon S3, the location
+// of the staged object is also included.
+type functionCodePackage struct {
+	zipFile         []byte
+	sha256          string
+	s3Bucket        string
+	s3Key           string
+	s3ObjectVersion string
+}
+
+func (p *functionCodePackage) isStaged() bool {
+	return p.s3Bucket != ""
+}
+
+func (p *functionCodePackage) applyToFunctionCode(code *types.FunctionCode) {
+	if p.isStaged() {
+		code.S3Bucket = aws.String(p.s3Bucket)
+		code.S3Key = aws.String(p.s3Key)
+		if p.s3ObjectVersion != "" {
+			code.S3ObjectVersion = aws.String(p.s3ObjectVersion)
+		}
+		return
+	}
+
+	code.ZipFile = p.zipFile
+}
+
+func (p *functionCodePackage) applyToUpdateFunctionCodeInput(input *lambda.UpdateFunctionCodeInput) {
+	if p.isStaged() {
+		input.S3Bucket = aws.String(p.s3Bucket)
+		input.S3Key = aws.String(p.s3Key)
+		if p.s3ObjectVersion != "" {
+			input.S3ObjectVersion = aws.String(p.s3ObjectVersion)
+		}
+		return
+	}
+
+	input.ZipFile = p.zipFile
+}
+
+func packageFunctionCodeFromPath(path string, blueprintDir string) (*functionCodePackage, error) {
+	files, err := utils.CollectArchiveFiles(path, blueprintDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect function code from %q: %w", path, err)
+	}
+
+	zipFile, err := utils.ZipDeterministic(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to package function code from %q: %w", path, err)
+	}
+
+	return &functionCodePackage{
+		zipFile: zipFile,
+		sha256:  functionCodeSHA256(zipFile),
+	}, nil
+}
+
+func functionCodeSHA256(zipFile []byte) string {
+	hash := sha256.Sum256(zipFile)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// packageFunctionCodeFromSpec builds a deployment package from the local
+// code.path field or the inline code.zipFile and code.files fields
+// in the provided function spec.
+// A relative code.path is resolved against the provided blueprint directory.
+// This returns nil if the spec does not contain code to be packaged
+// by the provider.
+func packageFunctionCodeFromSpec(
+	specData *core.MappingNode,
+	blueprintDir string,
+) (*functionCodePackage, error) {
+	path, hasPath := pluginutils.GetValueByPath("$.code.path", specData)
+	if hasPath {
+		for _, field := range []string{"zipFile", "files", "s3Bucket", "s3Key", "imageUri"} {
+			if _, hasField := pluginutils.GetValueByPath("$.code."+field, specData); hasField {
+				return nil, fmt.Errorf(
+					"code.path can not be used in combination with code.%s",
+					field,
+				)
+			}
+		}
+
+		return packageFunctionCodeFromPath(core.StringValue(path), blueprintDir)
+	}
+
+	_, hasZipFile := pluginutils.GetValueByPath("$.code.zipFile", specData)
+	_, hasFiles := pluginutils.GetValueByPath("$.code.files", specData)
+	if hasZipFile || hasFiles {
+		return packageInlineFunctionCode(specData)
+	}
+
+	return nil, nil
+}
+
+// packageInlineFunctionCode builds a deployment package from the inline
+// code.zipFile and code.files fields.
+// The code.zipFile field is added to the archive as the "index" file
+// for the runtime of the function, code.files is a map of relative
+// file paths to file contents that can be used on its own or to add
+// extra modules alongside code.zipFile.
+// Only the bootstrap file for custom runtimes is marked as executable,
+// all other files are added to the archive as regular files.
+func packageInlineFunctionCode(specData *core.MappingNode) (*functionCodePackage, error) {
+	files := []utils.ArchiveFile{}
+
+	var entrypoint *inlineCodeEntrypoint
+	zipFile, hasZipFile := pluginutils.GetValueByPath("$.code.zipFile", specData)
+	if hasZipFile {
+		runtime, _ := pluginutils.GetValueByPath("$.runtime", specData)
+		var err error
+		entrypoint, err = inlineCodeEntrypointForRuntime(core.StringValue(runtime))
+		if err != nil {
+			return nil, err
+		}
+
+		handler, hasHandler := pluginutils.GetValueByPath("$.handler", specData)
+		if hasHandler {
+			err = entrypoint.validateHandler(core.StringValue(handler))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		files = append(files, utils.ArchiveFile{
+			Name:       entrypoint.fileName,
+			Content:    []byte(core.StringValue(zipFile)),
+			Executable: entrypoint.executable,
+		})
+	}
+
+	runtime, _ := pluginutils.GetValueByPath("$.runtime", specData)
+	isCustomRuntime := getLanguageFromRuntime(core.StringValue(runtime)) == "provided"
+
+	inlineFiles, hasFiles := pluginutils.GetValueByPath("$.code.files", specData)
+	if hasFiles {
+		for filePath, content := range inlineFiles.Fields {
+			err := checkInlineFileEntrypointClash(filePath, entrypoint)
+			if err != nil {
+				return nil, err
+			}
+
+			files = append(files, utils.ArchiveFile{
+				Name:       filePath,
+				Content:    []byte(core.StringValue(content)),
+				Executable: isCustomRuntime && isInlineBootstrapFile(filePath),
+			})
+		}
+	}
+
+	zipBytes, err := utils.ZipFilesInMemory(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to package inline function code: %w", err)
+	}
+
+	return &functionCodePackage{
+		zipFile: zipBytes,
+		sha256:  functionCodeSHA256(zipBytes),
+	}, nil
+}
+
+// isInlineBootstrapFile determines whether a file in code.files
+// is the bootstrap file at the root of the package that is run
+// as the entrypoint for a custom runtime.
+func isInlineBootstrapFile(filePath string) bool {
+	cleanFilePath, err := utils.CleanArchivePath(filePath)
+	return err == nil && cleanFilePath == "bootstrap"
+}
+
+// checkInlineFileEntrypointClash ensures that a file in code.files
+// is not written to the same location as the code.zipFile entrypoint,
+// as one of the files would be silently dropped from the package.
+func checkInlineFileEntrypointClash(filePath string, entrypoint *inlineCodeEntrypoint) error {
+	if entrypoint == nil {
+		return nil
+	}
+
+	cleanFilePath, err := utils.CleanArchivePath(filePath)
+	if err != nil {
+		// Invalid paths are reported when the archive is built.
+		return nil
+	}
+
+	if cleanFilePath == entrypoint.fileName {
+		return fmt.Errorf(
+			"the code.files path %q clashes with the %s file generated for code.zipFile",
+			filePath,
+			entrypoint.fileName,
+		)
+	}
+
+	return nil
+}
+
+// inlineCodeEntrypoint holds the details of the file that
+// the code.zipFile field is written to in a deployment package.
+type inlineCodeEntrypoint struct {
+	fileName string
+	// The prefix that the function handler must start with
+	// for the function to load the code from the generated file.
+	// This is empty for custom runtimes where the handler is passed
+	// to the bootstrap script instead of being used to locate the code.
+	handlerPrefix string
+	executable    bool
+}
+
+func (e *inlineCodeEntrypoint) validateHandler(handler string) error {
+	if e.handlerPrefix == "" || strings.HasPrefix(handler, e.handlerPrefix) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"the handler %q must be of the form \"%s{handlerName}\" to load inline code from the %s file",
+		handler,
+		e.handlerPrefix,
+		e.fileName,
+	)
+}
+
+// inlineCodeEntrypointForRuntime determines the file that inline code is
+// written to for the given runtime.
+// Node.js, Python and Ruby code is written to an "index" file with the
+// extension for the language, custom runtimes (provided.*) expect
+// an executable file named "bootstrap" at the root of the package.
+func inlineCodeEntrypointForRuntime(runtime string) (*inlineCodeEntrypoint, error) {
+	language := getLanguageFromRuntime(runtime)
+	switch language {
+	case "nodejs", "python", "ruby":
+		return &inlineCodeEntrypoint{
+			fileName:      fmt.Sprintf("index.%s", getExtensionFromLanguage(language)),
+			handlerPrefix: "index.",
+		}, nil
+	case "provided":
+		return &inlineCodeEntrypoint{
+			fileName:   "bootstrap",
+			executable: true,
+		}, nil
+	}
+
+	return nil, fmt.Errorf(
+		"inline code is only supported for Node.js, Python, Ruby and custom (provided.*) runtimes, "+
+			"the %s runtime can not be used with inline code",
+		runtime,
+	)
+}
+
+// prepareFunctionCode builds the deployment package for a function spec
+// and stages it in Amazon S3 when it is too large to be uploaded directly.
+// Whether a package is staged depends only on its size, the code update
+// may still need to upload a package that matches the last recorded code hash
+// when the function has been changed outside of the provider.
+// Staged packages are content-addressed so a package that has already
+// been staged is not uploaded again.
+// This returns nil if the spec does not contain local code to package.
+func (l *lambdaFunctionResourceActions) prepareFunctionCode(
+	ctx context.Context,
+	providerContext provider.Context,
+	specData *core.MappingNode,
+) (*functionCodePackage, error) {
+	codePackage, err := packageFunctionCodeFromSpec(
+		specData,
+		functionCodeBlueprintDir(providerContext),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if codePackage == nil ||
+		len(codePackage.zipFile) <= functionCodeDirectUploadMaxSize {
+		return codePackage, nil
+	}
+
+	stagingBucket := functionCodeStagingBucket(specData, providerContext)
+	if stagingBucket == "" {
+		return nil, fmt.Errorf(
+			"the deployment package is %d bytes which exceeds the %d byte limit "+
+				"for direct uploads, set code.stagingBucket or the codeStagingBucket "+
+				"provider config field to stage the package in Amazon S3",
+			len(codePackage.zipFile),
+			functionCodeDirectUploadMaxSize,
+		)
+	}
+
+	s3Service, err := l.getS3Service(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stageFunctionCodePackage(ctx, s3Service, stagingBucket, codePackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return codePackage, nil
+}
+
+// blueprintDirContextVariable is the name of the context variable
+// that holds the directory of the blueprint file being deployed.
+const blueprintDirContextVariable = "blueprintDir"
+
+// functionCodeBlueprintDir resolves the directory that relative
+// code.path values are resolved against, when the blueprint directory
+// is not provided, relative paths are resolved against the current
+// working directory of the provider.
+func functionCodeBlueprintDir(providerContext provider.Context) string {
+	blueprintDir, hasBlueprintDir := providerContext.ContextVariable(
+		blueprintDirContextVariable,
+	)
+	if !hasBlueprintDir {
+		return ""
+	}
+
+	return core.StringValue(blueprintDir)
+}
+
+// functionCodeStagingBucket resolves the bucket used to stage large
+// deployment packages, the bucket set for the resource takes precedence
+// over the bucket set in the provider configuration.
+func functionCodeStagingBucket(
+	specData *core.MappingNode,
+	providerContext provider.Context,
+) string {
+	stagingBucket, hasStagingBucket := pluginutils.GetValueByPath(
+		"$.code.stagingBucket",
+		specData,
+	)
+	if hasStagingBucket && core.StringValue(stagingBucket) != "" {
+		return core.StringValue(stagingBucket)
+	}
+
+	providerStagingBucket, hasProviderStagingBucket := providerContext.ProviderConfigVariable(
+		"codeStagingBucket",
+	)
+	if hasProviderStagingBucket && !core.IsScalarNil(providerStagingBucket) {
+		return core.StringValueFromScalar(providerStagingBucket)
+	}
+
+	return ""
+}
+
+// stageFunctionCodePackage uploads a deployment package to the provided
+// S3 bucket under a key derived from the contents of the package.
+// As keys are content-addressed, packages that have already been staged
+// are not uploaded again.
+func stageFunctionCodePackage(
+	ctx context.Context,
+	s3Service s3.Service,
+	bucket string,
+	codePackage *functionCodePackage,
+) error {
+	key := functionCodeStagingKey(codePackage.zipFile)
+
+	headObjectOutput, err := s3Service.HeadObject(
+		ctx,
+		&s3sdk.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		},
+	)
+	if err == nil {
+		codePackage.s3Bucket = bucket
+		codePackage.s3Key = key
+		codePackage.s3ObjectVersion = aws.ToString(headObjectOutput.VersionId)
+		return nil
+	}
+
+	var notFoundErr *s3types.NotFound
+	if !errors.As(err, &notFoundErr) {
+		return fmt.Errorf("failed to check for staged deployment package %q: %w", key, err)
+	}
+
+	putObjectOutput, err := s3Service.PutObject(
+		ctx,
+		&s3sdk.PutObjectInput{
+			Bucket:         aws.String(bucket),
+			Key:            aws.String(key),
+			Body:           bytes.NewReader(codePackage.zipFile),
+			ContentLength:  aws.Int64(int64(len(codePackage.zipFile))),
+			ContentType:    aws.String("application/zip"),
+			ChecksumSHA256: aws.String(codePackage.sha256),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to stage deployment package %q: %w", key, err)
+	}
+
+	codePackage.s3Bucket = bucket
+	codePackage.s3Key = key
+	codePackage.s3ObjectVersion = aws.ToString(putObjectOutput.VersionId)
+	return nil
+}
+
+func functionCodeStagingKey(zipFile []byte) string {
+	hash := sha256.Sum256(zipFile)
+	return fmt.Sprintf(
+		"%s/%s.zip",
+		functionCodeStagingKeyPrefix,
+		hex.EncodeToString(hash[:]),
+	)
+}
+
+// packagedFunctionCodeSHA256 returns the hash of the deployment package
+// that the provider will upload for a code update.
+// This returns an empty string when the update does not include
+// a package built by the provider.
+func packagedFunctionCodeSHA256(
+	input *lambda.UpdateFunctionCodeInput,
+	codePackage *functionCodePackage,
+) string {
+	// code.path can not be combined with other code source fields,
+	// so the package is the only code that can be included in the update.
+	if codePackage != nil {
+		return codePackage.sha256
+	}
+
+	if len(input.ZipFile) > 0 {
+		return functionCodeSHA256(input.ZipFile)
+	}
+
+	return ""
+}
+
+// resolveS3FunctionCodeSHA256 resolves the hash of a deployment package
+// sourced from Amazon S3 when the code source fields for the function
+// have changed, this allows code updates that point to a package with
+// the same contents as the deployed code to be skipped.
+// This returns an empty string when the spec does not source code from
+// Amazon S3 or there are no changes to the code source fields.
+func (l *lambdaFunctionResourceActions) resolveS3FunctionCodeSHA256(
+	ctx context.Context,
+	providerContext provider.Context,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (string, error) {
+	s3Bucket, hasS3Bucket := pluginutils.GetValueByPath("$.code.s3Bucket", specData)
+	s3Key, hasS3Key := pluginutils.GetValueByPath("$.code.s3Key", specData)
+	if !hasS3Bucket || !hasS3Key || !hasFunctionCodeSourceChanges(changes) {
+		return "", nil
+	}
+
+	s3Service, err := l.getS3Service(ctx, providerContext)
+	if err != nil {
+		return "", err
+	}
+
+	s3ObjectVersion, _ := pluginutils.GetValueByPath("$.code.s3ObjectVersion", specData)
+	return s3FunctionCodeSHA256(
+		ctx,
+		s3Service,
+		core.StringValue(s3Bucket),
+		core.StringValue(s3Key),
+		core.StringValue(s3ObjectVersion),
+	)
+}
+
+func hasFunctionCodeSourceChanges(changes *provider.Changes) bool {
+	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
+	for _, fieldChange := range modifiedFields {
+		if fieldChange.FieldPath == "spec.code" ||
+			strings.HasPrefix(fieldChange.FieldPath, "spec.code.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// s3FunctionCodeSHA256 computes the hash of a deployment package in Amazon S3
+// in the same base64-encoded format that Lambda reports as the CodeSha256
+// of a function.
+// The SHA-256 checksum stored with the object is used when available,
+// otherwise the object is downloaded to compute the hash.
+func s3FunctionCodeSHA256(
+	ctx context.Context,
+	s3Service s3.Service,
+	bucket string,
+	key string,
+	version string,
+) (string, error) {
+	headObjectInput := &s3sdk.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		Key:          aws.String(key),
+		ChecksumMode: s3types.ChecksumModeEnabled,
+	}
+	if version != "" {
+		headObjectInput.VersionId = aws.String(version)
+	}
+
+	headObjectOutput, err := s3Service.HeadObject(ctx, headObjectInput)
+	if err != nil {
+		return "", fmt.Errorf("failed to get deployment package %q from bucket %q: %w", key, bucket, err)
+	}
+
+	// Checksums for objects uploaded in multiple parts are a hash of the checksums
+	// of each part, suffixed with the number of parts, these can not be compared
+	// with the hash of the whole package.
+	checksum := aws.ToString(headObjectOutput.ChecksumSHA256)
+	if checksum != "" && !strings.Contains(checksum, "-") {
+		return checksum, nil
+	}
+
+	getObjectInput := &s3sdk.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if version != "" {
+		getObjectInput.VersionId = aws.String(version)
+	}
+
+	getObjectOutput, err := s3Service.GetObject(ctx, getObjectInput)
+	if err != nil {
+		return "", fmt.Errorf("failed to get deployment package %q from bucket %q: %w", key, bucket, err)
+	}
+	defer getObjectOutput.Body.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, getObjectOutput.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read deployment package %q from bucket %q: %w", key, bucket, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// canSkipIfCodeUnchanged determines whether a code update can be skipped
+// when the deployment package matches the code that is already deployed.
+// Packages built by the provider and packages in Amazon S3 can be compared,
+// for a container image the resolved image digest is compared instead
+// when the update input is prepared.
+// A change in architecture must always be applied, even for the same package.
+func canSkipIfCodeUnchanged(
+	input *lambda.UpdateFunctionCodeInput,
+	codeSha256 string,
+) bool {
+	return codeSha256 != "" &&
+		len(input.Architectures) == 0 &&
+		input.ImageUri == nil
+}
+
+// deployedFunctionCodeMatches compares the SHA-256 hash of the deployment
+// package the provider would upload with the hash of the code that is
+// currently deployed for the function.
+func deployedFunctionCodeMatches(
+	ctx context.Context,
+	lambdaService Service,
+	functionName string,
+	codeSha256 string,
+) (bool, error) {
+	getFunctionOutput, err := lambdaService.GetFunction(
+		ctx,
+		&lambda.GetFunctionInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to get deployed function code hash: %w", err)
+	}
+
+	if getFunctionOutput.Configuration == nil {
+		return false, nil
+	}
+
+	deployedCodeSha256 := aws.ToString(getFunctionOutput.Configuration.CodeSha256)
+	return deployedCodeSha256 != "" && deployedCodeSha256 == codeSha256, nil
+}
@@ -0,0 +1,196 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// FunctionCodeSigningConfigLink returns a link implementation that attaches
+// a code signing configuration to an AWS Lambda function.
+func FunctionCodeSigningConfigLink(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Link {
+	linkActions := &lambdaFunctionCodeSigningConfigLinkActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.LinkDefinition{
+		ResourceTypeA: "aws/lambda/function",
+		ResourceTypeB: "aws/lambda/codeSigningConfig",
+		// The code signing configuration must exist before it can be attached
+		// to the function.
+		Kind:             provider.LinkKindHard,
+		PriorityResource: provider.LinkPriorityResourceB,
+		PlainTextSummary: "A link that attaches a code signing configuration to an AWS Lambda function.",
+		FormattedDescription: "A link that attaches a [code signing configuration](https://docs.aws.amazon.com/lambda/latest/dg/configuration-codesigning.html) " +
+			"to a Lambda function, this is an alternative to setting `codeSigningConfigArn` in the function spec " +
+			"that does not require the ARN of the code signing configuration to be known. " +
+			"`codeSigningConfigArn` should not be set for a function that links to a code signing configuration.",
+		StageChangesFunc:                linkActions.StageChanges,
+		UpdateResourceAFunc:             linkActions.UpdateFunction,
+		UpdateResourceBFunc:             linkActions.UpdateCodeSigningConfig,
+		UpdateIntermediaryResourcesFunc: linkActions.UpdateIntermediaryResources,
+	}
+}
+
+type lambdaFunctionCodeSigningConfigLinkActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaFunctionCodeSigningConfigLinkActions) getLambdaService(
+	ctx context.Context,
+	linkContext provider.LinkContext,
+) (Service, error) {
+	providerContext := provider.NewProviderContextFromLinkContext(linkContext, "aws")
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
+
+func (l *lambdaFunctionCodeSigningConfigLinkActions) StageChanges(
+	ctx context.Context,
+	input *provider.LinkStageChangesInput,
+) (*provider.LinkStageChangesOutput, error) {
+	// The link does not have any configuration of its own,
+	// the code signing configuration is attached to the function
+	// based on the state of the linked resources.
+	return &provider.LinkStageChangesOutput{
+		Changes: &provider.LinkChanges{},
+	}, nil
+}
+
+func (l *lambdaFunctionCodeSigningConfigLinkActions) UpdateFunction(
+	ctx context.Context,
+	input *provider.LinkUpdateResourceInput,
+) (*provider.LinkUpdateResourceOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.LinkContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionARN, err := linkedResourceSpecField(input.ResourceInfo, "arn")
+	if err != nil {
+		return nil, err
+	}
+
+	if input.LinkUpdateType == provider.LinkUpdateTypeDestroy {
+		return l.detachCodeSigningConfig(ctx, functionARN, lambdaService)
+	}
+
+	codeSigningConfigARN, err := linkedResourceSpecField(
+		input.OtherResourceInfo,
+		"codeSigningConfigArn",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = lambdaService.PutFunctionCodeSigningConfig(
+		ctx,
+		&lambda.PutFunctionCodeSigningConfigInput{
+			FunctionName:         aws.String(functionARN),
+			CodeSigningConfigArn: aws.String(codeSigningConfigARN),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"codeSigningConfigArn": core.MappingNodeFromString(codeSigningConfigARN),
+			},
+		},
+	}, nil
+}
+
+// When the link is removed, the function may have already been destroyed,
+// a function that no longer exists does not need to be detached
+// from the code signing configuration.
+func (l *lambdaFunctionCodeSigningConfigLinkActions) detachCodeSigningConfig(
+	ctx context.Context,
+	functionARN string,
+	lambdaService Service,
+) (*provider.LinkUpdateResourceOutput, error) {
+	_, err := lambdaService.DeleteFunctionCodeSigningConfig(
+		ctx,
+		&lambda.DeleteFunctionCodeSigningConfigInput{
+			FunctionName: aws.String(functionARN),
+		},
+	)
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if !errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+	}
+
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}, nil
+}
+
+func (l *lambdaFunctionCodeSigningConfigLinkActions) UpdateCodeSigningConfig(
+	ctx context.Context,
+	input *provider.LinkUpdateResourceInput,
+) (*provider.LinkUpdateResourceOutput, error) {
+	// The code signing configuration does not need to be aware
+	// of the functions that use it.
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}, nil
+}
+
+func (l *lambdaFunctionCodeSigningConfigLinkActions) UpdateIntermediaryResources(
+	ctx context.Context,
+	input *provider.LinkUpdateIntermediaryResourcesInput,
+) (*provider.LinkUpdateIntermediaryResourcesOutput, error) {
+	// There are no intermediary resources for this link.
+	return &provider.LinkUpdateIntermediaryResourcesOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}, nil
+}
+
+func linkedResourceSpecField(
+	resourceInfo *provider.ResourceInfo,
+	field string,
+) (string, error) {
+	if resourceInfo == nil || resourceInfo.CurrentResourceState == nil {
+		return "", fmt.Errorf("the current state of the linked resource is not available")
+	}
+
+	value, hasValue := pluginutils.GetValueByPath(
+		"$."+field,
+		resourceInfo.CurrentResourceState.SpecData,
+	)
+	if !hasValue {
+		return "", fmt.Errorf(
+			"%q is missing from the current state of linked resource %q",
+			field,
+			resourceInfo.ResourceName,
+		)
+	}
+
+	return core.StringValue(value), nil
+}
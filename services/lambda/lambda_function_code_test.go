@@ -0,0 +1,414 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionCodeSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionCodeSuite) Test_stages_package_that_has_not_been_uploaded() {
+	codePackage := testFunctionCodePackage()
+	service := createS3ServiceMock(
+		WithHeadObjectError(&s3types.NotFound{}),
+		WithPutObjectOutput(&s3sdk.PutObjectOutput{
+			VersionId: aws.String("new-version"),
+		}),
+	)
+
+	err := stageFunctionCodePackage(context.Background(), service, "staging-bucket", codePackage)
+	s.Require().NoError(err)
+	s.Assert().Equal("staging-bucket", codePackage.s3Bucket)
+	s.Assert().Equal(functionCodeStagingKey(codePackage.zipFile), codePackage.s3Key)
+	s.Assert().Equal("new-version", codePackage.s3ObjectVersion)
+	s.Assert().Regexp("^celerity/lambda/[0-9a-f]{64}\\.zip$", codePackage.s3Key)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_reuses_package_that_has_already_been_staged() {
+	codePackage := testFunctionCodePackage()
+	service := createS3ServiceMock(
+		WithHeadObjectOutput(&s3sdk.HeadObjectOutput{
+			VersionId: aws.String("existing-version"),
+		}),
+		WithPutObjectError(errors.New("package should not be uploaded again")),
+	)
+
+	err := stageFunctionCodePackage(context.Background(), service, "staging-bucket", codePackage)
+	s.Require().NoError(err)
+	s.Assert().Equal("staging-bucket", codePackage.s3Bucket)
+	s.Assert().Equal("existing-version", codePackage.s3ObjectVersion)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_fails_to_stage_package_when_upload_fails() {
+	codePackage := testFunctionCodePackage()
+	service := createS3ServiceMock(
+		WithHeadObjectError(&s3types.NotFound{}),
+		WithPutObjectError(errors.New("access denied")),
+	)
+
+	err := stageFunctionCodePackage(context.Background(), service, "staging-bucket", codePackage)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "failed to stage deployment package")
+	s.Assert().False(codePackage.isStaged())
+}
+
+func (s *LambdaFunctionCodeSuite) Test_fails_to_stage_package_when_bucket_check_fails() {
+	codePackage := testFunctionCodePackage()
+	service := createS3ServiceMock(
+		WithHeadObjectError(errors.New("access denied")),
+	)
+
+	err := stageFunctionCodePackage(context.Background(), service, "staging-bucket", codePackage)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "failed to check for staged deployment package")
+}
+
+func (s *LambdaFunctionCodeSuite) Test_resource_staging_bucket_takes_precedence() {
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"codeStagingBucket": core.ScalarFromString("provider-bucket"),
+		},
+		map[string]*core.ScalarValue{},
+	)
+
+	specWithBucket := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path":          core.MappingNodeFromString(testFunctionCodePath),
+					"stagingBucket": core.MappingNodeFromString("resource-bucket"),
+				},
+			},
+		},
+	}
+	s.Assert().Equal("resource-bucket", functionCodeStagingBucket(specWithBucket, providerCtx))
+
+	specWithoutBucket := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+		},
+	}
+	s.Assert().Equal("provider-bucket", functionCodeStagingBucket(specWithoutBucket, providerCtx))
+}
+
+func (s *LambdaFunctionCodeSuite) Test_staged_package_is_applied_as_s3_location() {
+	codePackage := testFunctionCodePackage()
+	codePackage.s3Bucket = "staging-bucket"
+	codePackage.s3Key = "celerity/lambda/abc.zip"
+	codePackage.s3ObjectVersion = "version-1"
+
+	code := &types.FunctionCode{}
+	codePackage.applyToFunctionCode(code)
+	s.Assert().Equal(
+		&types.FunctionCode{
+			S3Bucket:        aws.String("staging-bucket"),
+			S3Key:           aws.String("celerity/lambda/abc.zip"),
+			S3ObjectVersion: aws.String("version-1"),
+		},
+		code,
+	)
+
+	input := &lambda.UpdateFunctionCodeInput{}
+	codePackage.applyToUpdateFunctionCodeInput(input)
+	s.Assert().Equal(
+		&lambda.UpdateFunctionCodeInput{
+			S3Bucket:        aws.String("staging-bucket"),
+			S3Key:           aws.String("celerity/lambda/abc.zip"),
+			S3ObjectVersion: aws.String("version-1"),
+		},
+		input,
+	)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_resolves_relative_code_path_against_blueprint_dir() {
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{
+			blueprintDirContextVariable: core.ScalarFromString("__testdata"),
+		},
+	)
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString("function_code"),
+				},
+			},
+		},
+	}
+
+	codePackage, err := packageFunctionCodeFromSpec(
+		specData,
+		functionCodeBlueprintDir(providerCtx),
+	)
+	s.Require().NoError(err)
+
+	expectedPackage, err := packageFunctionCodeFromPath(testFunctionCodePath, "")
+	s.Require().NoError(err)
+	s.Assert().Equal(expectedPackage.sha256, codePackage.sha256)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_packages_inline_ruby_code_as_index_file() {
+	codePackage, err := packageFunctionCodeFromSpec(inlineCodeSpec(
+		"ruby3.3",
+		"index.handler",
+		"def handler(event:, context:) end",
+	), "")
+	s.Require().NoError(err)
+
+	files := readZipEntries(&s.Suite, codePackage.zipFile)
+	s.Require().Len(files, 1)
+	s.Assert().Equal("index.rb", files[0].Name)
+	s.Assert().Equal(os.FileMode(0644), files[0].Mode().Perm())
+}
+
+func (s *LambdaFunctionCodeSuite) Test_packages_inline_custom_runtime_code_as_executable_bootstrap() {
+	codePackage, err := packageFunctionCodeFromSpec(inlineCodeSpec(
+		"provided.al2023",
+		"function.handler",
+		"#!/bin/sh\necho \"Hello, World!\"",
+	), "")
+	s.Require().NoError(err)
+
+	files := readZipEntries(&s.Suite, codePackage.zipFile)
+	s.Require().Len(files, 1)
+	s.Assert().Equal("bootstrap", files[0].Name)
+	s.Assert().Equal(os.FileMode(0755), files[0].Mode().Perm())
+}
+
+func (s *LambdaFunctionCodeSuite) Test_packages_only_bootstrap_as_executable_for_custom_runtime() {
+	specData := inlineCodeSpec(
+		"provided.al2023",
+		"function.handler",
+		"#!/bin/sh\n. ./lib/handler.sh",
+	)
+	specData.Fields["code"].Fields["files"] = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"lib/handler.sh": core.MappingNodeFromString("#!/bin/sh\necho \"Hello, World!\""),
+		},
+	}
+
+	codePackage, err := packageFunctionCodeFromSpec(specData, "")
+	s.Require().NoError(err)
+
+	files := readZipEntries(&s.Suite, codePackage.zipFile)
+	s.Require().Len(files, 2)
+	fileModes := map[string]os.FileMode{}
+	for _, file := range files {
+		fileModes[file.Name] = file.Mode().Perm()
+	}
+	s.Assert().Equal(
+		map[string]os.FileMode{
+			"bootstrap":      0755,
+			"lib/handler.sh": 0644,
+		},
+		fileModes,
+	)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_packages_bootstrap_from_inline_files_as_executable_for_custom_runtime() {
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"runtime": core.MappingNodeFromString("provided.al2023"),
+			"handler": core.MappingNodeFromString("function.handler"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"files": {
+						Fields: map[string]*core.MappingNode{
+							"./bootstrap":    core.MappingNodeFromString("#!/bin/sh\n. ./lib/handler.sh"),
+							"lib/handler.sh": core.MappingNodeFromString("#!/bin/sh\necho \"Hello, World!\""),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	codePackage, err := packageFunctionCodeFromSpec(specData, "")
+	s.Require().NoError(err)
+
+	files := readZipEntries(&s.Suite, codePackage.zipFile)
+	s.Require().Len(files, 2)
+	fileModes := map[string]os.FileMode{}
+	for _, file := range files {
+		fileModes[file.Name] = file.Mode().Perm()
+	}
+	s.Assert().Equal(
+		map[string]os.FileMode{
+			"bootstrap":      0755,
+			"lib/handler.sh": 0644,
+		},
+		fileModes,
+	)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_packages_inline_files_for_managed_runtime_as_non_executable() {
+	specData := inlineCodeSpec(
+		"nodejs22.x",
+		"index.handler",
+		"exports.handler = require('./lib/greet').greet;",
+	)
+	specData.Fields["code"].Fields["files"] = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"lib/greet.js": core.MappingNodeFromString("exports.greet = async () => 'Hello';"),
+		},
+	}
+
+	codePackage, err := packageFunctionCodeFromSpec(specData, "")
+	s.Require().NoError(err)
+
+	files := readZipEntries(&s.Suite, codePackage.zipFile)
+	s.Require().Len(files, 2)
+	for _, file := range files {
+		s.Assert().Equal(os.FileMode(0644), file.Mode().Perm(), file.Name)
+	}
+}
+
+func (s *LambdaFunctionCodeSuite) Test_fails_to_package_inline_code_for_handler_that_does_not_match_file() {
+	_, err := packageFunctionCodeFromSpec(inlineCodeSpec(
+		"ruby3.3",
+		"app.handler",
+		"def handler(event:, context:) end",
+	), "")
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "must be of the form \"index.{handlerName}\"")
+}
+
+func (s *LambdaFunctionCodeSuite) Test_fails_to_package_inline_code_for_unsupported_runtime() {
+	_, err := packageFunctionCodeFromSpec(inlineCodeSpec(
+		"java21",
+		"index.handler",
+		"public class Handler {}",
+	), "")
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "the java21 runtime can not be used with inline code")
+}
+
+func (s *LambdaFunctionCodeSuite) Test_fails_to_package_inline_file_that_clashes_with_entrypoint() {
+	specData := inlineCodeSpec(
+		"nodejs22.x",
+		"index.handler",
+		"exports.handler = async () => {};",
+	)
+	specData.Fields["code"].Fields["files"] = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"./index.js": core.MappingNodeFromString("module.exports = {};"),
+		},
+	}
+
+	_, err := packageFunctionCodeFromSpec(specData, "")
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "clashes with the index.js file generated for code.zipFile")
+}
+
+func (s *LambdaFunctionCodeSuite) Test_uses_stored_checksum_for_s3_package_hash() {
+	codePackage := testFunctionCodePackage()
+	service := createS3ServiceMock(
+		WithHeadObjectOutput(&s3sdk.HeadObjectOutput{
+			ChecksumSHA256: aws.String(codePackage.sha256),
+		}),
+		WithGetObjectError(errors.New("package should not be downloaded")),
+	)
+
+	codeSha256, err := s3FunctionCodeSHA256(
+		context.Background(),
+		service,
+		"test-bucket",
+		"function.zip",
+		"",
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(codePackage.sha256, codeSha256)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_downloads_s3_package_to_compute_hash_for_multipart_checksum() {
+	codePackage := testFunctionCodePackage()
+	service := createS3ServiceMock(
+		WithHeadObjectOutput(&s3sdk.HeadObjectOutput{
+			ChecksumSHA256: aws.String("bXVsdGlwYXJ0LWNoZWNrc3Vt-3"),
+		}),
+		WithGetObjectOutput(&s3sdk.GetObjectOutput{
+			Body: io.NopCloser(bytes.NewReader(codePackage.zipFile)),
+		}),
+	)
+
+	codeSha256, err := s3FunctionCodeSHA256(
+		context.Background(),
+		service,
+		"test-bucket",
+		"function.zip",
+		"version-1",
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(codePackage.sha256, codeSha256)
+}
+
+func (s *LambdaFunctionCodeSuite) Test_fails_to_compute_s3_package_hash_when_object_check_fails() {
+	service := createS3ServiceMock(
+		WithHeadObjectError(errors.New("access denied")),
+	)
+
+	_, err := s3FunctionCodeSHA256(
+		context.Background(),
+		service,
+		"test-bucket",
+		"function.zip",
+		"",
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "failed to get deployment package")
+}
+
+func inlineCodeSpec(runtime string, handler string, code string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"runtime": core.MappingNodeFromString(runtime),
+			"handler": core.MappingNodeFromString(handler),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"zipFile": core.MappingNodeFromString(code),
+				},
+			},
+		},
+	}
+}
+
+func readZipEntries(s *suite.Suite, zipFile []byte) []*zip.File {
+	reader, err := zip.NewReader(bytes.NewReader(zipFile), int64(len(zipFile)))
+	s.Require().NoError(err)
+	return reader.File
+}
+
+func testFunctionCodePackage() *functionCodePackage {
+	zipFile := []byte("test deployment package")
+	return &functionCodePackage{
+		zipFile: zipFile,
+		sha256:  functionCodeSHA256(zipFile),
+	}
+}
+
+func TestLambdaFunctionCodeSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionCodeSuite))
+}
@@ -0,0 +1,192 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrsdk "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+// The tag that is used when an image URI does not include a tag or digest,
+// this matches the behaviour of Lambda and the Docker CLI.
+const defaultImageTag = "latest"
+
+var ecrRegistryPattern = regexp.MustCompile(
+	`^(\d{12})\.dkr\.ecr(-fips)?\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`,
+)
+
+// functionImage holds the container image that is deployed
+// for a function with the Image package type.
+type functionImage struct {
+	// The URI of the image pinned to the digest that the tag
+	// in the code.imageUri field resolved to.
+	pinnedURI string
+	digest    string
+}
+
+// ecrImageReference holds the parts of a container image URI
+// for an image stored in an Amazon ECR repository.
+type ecrImageReference struct {
+	registry   string
+	registryID string
+	repository string
+	tag        string
+	digest     string
+}
+
+// parseECRImageURI parses a container image URI of the form
+// "{accountId}.dkr.ecr.{region}.amazonaws.com/{repository}[:{tag}|@{digest}]".
+// This returns false if the URI does not refer to an image in an Amazon ECR repository.
+func parseECRImageURI(imageURI string) (*ecrImageReference, bool) {
+	registry, repositoryAndRef, hasRepository := strings.Cut(imageURI, "/")
+	if !hasRepository {
+		return nil, false
+	}
+
+	registryMatch := ecrRegistryPattern.FindStringSubmatch(registry)
+	if registryMatch == nil {
+		return nil, false
+	}
+
+	ref := &ecrImageReference{
+		registry:   registry,
+		registryID: registryMatch[1],
+	}
+
+	if repository, digest, hasDigest := strings.Cut(repositoryAndRef, "@"); hasDigest {
+		ref.repository = repository
+		ref.digest = digest
+	} else {
+		ref.repository = repositoryAndRef
+		ref.tag = defaultImageTag
+		// The tag separator is the last colon after the final path segment,
+		// repository names can not contain colons.
+		lastSlash := strings.LastIndex(repositoryAndRef, "/")
+		if colon := strings.LastIndex(repositoryAndRef, ":"); colon > lastSlash {
+			ref.repository = repositoryAndRef[:colon]
+			ref.tag = repositoryAndRef[colon+1:]
+		}
+	}
+
+	if ref.repository == "" || (ref.digest == "" && ref.tag == "") {
+		return nil, false
+	}
+
+	return ref, true
+}
+
+func (r *ecrImageReference) pinnedURI(digest string) string {
+	return fmt.Sprintf("%s/%s@%s", r.registry, r.repository, digest)
+}
+
+// resolveFunctionImage resolves the tag in the code.imageUri field of the provided
+// function spec to the digest of the image that the tag currently points to.
+// Lambda resolves a tag to a digest once when the function code is deployed,
+// so resolving the digest in the provider allows a tag that has been moved
+// to a new image to be detected as a code change.
+// This returns nil if the spec does not contain an image URI for an image
+// stored in Amazon ECR.
+func (l *lambdaFunctionResourceActions) resolveFunctionImage(
+	ctx context.Context,
+	providerContext provider.Context,
+	specData *core.MappingNode,
+) (*functionImage, error) {
+	imageURI, hasImageURI := pluginutils.GetValueByPath("$.code.imageUri", specData)
+	if !hasImageURI {
+		return nil, nil
+	}
+
+	ref, isECRImage := parseECRImageURI(core.StringValue(imageURI))
+	if !isECRImage {
+		return nil, nil
+	}
+
+	if ref.digest != "" {
+		return &functionImage{
+			pinnedURI: core.StringValue(imageURI),
+			digest:    ref.digest,
+		}, nil
+	}
+
+	ecrService, err := l.getECRService(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := resolveImageTagDigest(ctx, ecrService, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionImage{
+		pinnedURI: ref.pinnedURI(digest),
+		digest:    digest,
+	}, nil
+}
+
+func resolveImageTagDigest(
+	ctx context.Context,
+	ecrService ecr.Service,
+	ref *ecrImageReference,
+) (string, error) {
+	output, err := ecrService.DescribeImages(
+		ctx,
+		&ecrsdk.DescribeImagesInput{
+			RegistryId:     aws.String(ref.registryID),
+			RepositoryName: aws.String(ref.repository),
+			ImageIds: []ecrtypes.ImageIdentifier{
+				{
+					ImageTag: aws.String(ref.tag),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to resolve the digest for tag %q in repository %q: %w",
+			ref.tag,
+			ref.repository,
+			err,
+		)
+	}
+
+	if len(output.ImageDetails) == 0 ||
+		aws.ToString(output.ImageDetails[0].ImageDigest) == "" {
+		return "", fmt.Errorf(
+			"no image was found for tag %q in repository %q",
+			ref.tag,
+			ref.repository,
+		)
+	}
+
+	return aws.ToString(output.ImageDetails[0].ImageDigest), nil
+}
+
+// imageDigestFromURI extracts the digest from an image URI
+// that has been pinned to a digest.
+func imageDigestFromURI(imageURI string) string {
+	_, digest, hasDigest := strings.Cut(imageURI, "@")
+	if !hasDigest {
+		return ""
+	}
+
+	return digest
+}
+
+// isSameImageRepository determines whether two image URIs
+// refer to images in the same ECR repository.
+func isSameImageRepository(imageURIA string, imageURIB string) bool {
+	refA, isECRImageA := parseECRImageURI(imageURIA)
+	refB, isECRImageB := parseECRImageURI(imageURIB)
+	return isECRImageA && isECRImageB &&
+		refA.registry == refB.registry &&
+		refA.repository == refB.repository
+}
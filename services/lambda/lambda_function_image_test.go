@@ -0,0 +1,173 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrsdk "github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionImageSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionImageSuite) Test_parses_ecr_image_uris() {
+	testCases := []struct {
+		imageURI string
+		expected *ecrImageReference
+	}{
+		{
+			imageURI: "123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app:v1",
+			expected: &ecrImageReference{
+				registry:   "123456789012.dkr.ecr.us-east-1.amazonaws.com",
+				registryID: "123456789012",
+				repository: "my-app",
+				tag:        "v1",
+			},
+		},
+		{
+			imageURI: "123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/my-app",
+			expected: &ecrImageReference{
+				registry:   "123456789012.dkr.ecr.eu-west-2.amazonaws.com",
+				registryID: "123456789012",
+				repository: "team/my-app",
+				tag:        "latest",
+			},
+		},
+		{
+			imageURI: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/my-app@" + testImageDigest,
+			expected: &ecrImageReference{
+				registry:   "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn",
+				registryID: "123456789012",
+				repository: "my-app",
+				digest:     testImageDigest,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ref, isECRImage := parseECRImageURI(testCase.imageURI)
+		s.Assert().True(isECRImage, testCase.imageURI)
+		s.Assert().Equal(testCase.expected, ref, testCase.imageURI)
+	}
+}
+
+func (s *LambdaFunctionImageSuite) Test_does_not_parse_images_outside_of_ecr() {
+	for _, imageURI := range []string{
+		"docker.io/library/node:22",
+		"public.ecr.aws/lambda/nodejs:22",
+		"my-app:latest",
+	} {
+		_, isECRImage := parseECRImageURI(imageURI)
+		s.Assert().False(isECRImage, imageURI)
+	}
+}
+
+func (s *LambdaFunctionImageSuite) Test_resolves_image_tag_to_digest() {
+	ecrService := createTestImageECRServiceMock(testImageDigest)
+
+	image, err := s.resolveFunctionImage(
+		ecrService,
+		"123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app:v1",
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		&functionImage{
+			pinnedURI: "123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app@" + testImageDigest,
+			digest:    testImageDigest,
+		},
+		image,
+	)
+}
+
+func (s *LambdaFunctionImageSuite) Test_uses_digest_from_pinned_image_uri() {
+	ecrService := createECRServiceMock(
+		WithDescribeImagesError(errors.New("images should not be described for a pinned image")),
+	)
+
+	imageURI := "123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app@" + testImageDigest
+	image, err := s.resolveFunctionImage(ecrService, imageURI)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		&functionImage{
+			pinnedURI: imageURI,
+			digest:    testImageDigest,
+		},
+		image,
+	)
+}
+
+func (s *LambdaFunctionImageSuite) Test_fails_when_image_tag_does_not_exist() {
+	ecrService := createECRServiceMock(
+		WithDescribeImagesOutput(&ecrsdk.DescribeImagesOutput{}),
+	)
+
+	_, err := s.resolveFunctionImage(
+		ecrService,
+		"123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app:missing",
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "no image was found for tag \"missing\" in repository \"my-app\"")
+}
+
+func (s *LambdaFunctionImageSuite) Test_extracts_digest_from_pinned_image_uri() {
+	s.Assert().Equal(
+		testImageDigest,
+		imageDigestFromURI("123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app@"+testImageDigest),
+	)
+	s.Assert().Equal(
+		"",
+		imageDigestFromURI("123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app:latest"),
+	)
+}
+
+func (s *LambdaFunctionImageSuite) resolveFunctionImage(
+	ecrService ecr.Service,
+	imageURI string,
+) (*functionImage, error) {
+	actions := &lambdaFunctionResourceActions{
+		ecrServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) ecr.Service {
+			return ecrService
+		},
+		awsConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			&testutils.MockAWSConfigLoader{},
+		),
+	}
+
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-east-1"),
+		},
+		map[string]*core.ScalarValue{},
+	)
+
+	return actions.resolveFunctionImage(
+		context.Background(),
+		providerCtx,
+		&core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"code": {
+					Fields: map[string]*core.MappingNode{
+						"imageUri": core.MappingNodeFromString(imageURI),
+					},
+				},
+			},
+		},
+	)
+}
+
+func TestLambdaFunctionImageSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionImageSuite))
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
+	"github.com/newstack-cloud/celerity-provider-aws/services/ecr"
+	"github.com/newstack-cloud/celerity-provider-aws/services/s3"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
@@ -13,14 +15,19 @@ import (
 // FunctionResource returns a resource implementation for an AWS Lambda Function.
 func FunctionResource(
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	s3ServiceFactory pluginutils.ServiceFactory[*aws.Config, s3.Service],
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecr.Service],
 	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
 ) provider.Resource {
 	yamlExample, _ := examples.ReadFile("examples/resources/lambda_function_yaml.md")
 	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_function_jsonc.md")
 	yamlInlineExample, _ := examples.ReadFile("examples/resources/lambda_function_inline_yaml.md")
+	yamlLocalPathExample, _ := examples.ReadFile("examples/resources/lambda_function_local_path_yaml.md")
 
 	lambdaFunctionActions := &lambdaFunctionResourceActions{
 		lambdaServiceFactory,
+		s3ServiceFactory,
+		ecrServiceFactory,
 		awsConfigStore,
 	}
 	return &providerv1.ResourceDefinition{
@@ -39,8 +46,11 @@ func FunctionResource(
 			string(yamlExample),
 			string(jsoncExample),
 			string(yamlInlineExample),
+			string(yamlLocalPathExample),
+		},
+		ResourceCanLinkTo: []string{
+			"aws/lambda/codeSigningConfig",
 		},
-		ResourceCanLinkTo:    []string{},
 		GetExternalStateFunc: lambdaFunctionActions.GetExternalState,
 		CreateFunc:           lambdaFunctionActions.Create,
 		UpdateFunc:           lambdaFunctionActions.Update,
@@ -51,6 +61,8 @@ func FunctionResource(
 
 type lambdaFunctionResourceActions struct {
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	s3ServiceFactory     pluginutils.ServiceFactory[*aws.Config, s3.Service]
+	ecrServiceFactory    pluginutils.ServiceFactory[*aws.Config, ecr.Service]
 	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
 }
 
@@ -65,3 +77,27 @@ func (l *lambdaFunctionResourceActions) getLambdaService(
 
 	return l.lambdaServiceFactory(awsConfig, providerContext), nil
 }
+
+func (l *lambdaFunctionResourceActions) getS3Service(
+	ctx context.Context,
+	providerContext provider.Context,
+) (s3.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.s3ServiceFactory(awsConfig, providerContext), nil
+}
+
+func (l *lambdaFunctionResourceActions) getECRService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (ecr.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.ecrServiceFactory(awsConfig, providerContext), nil
+}
@@ -3,6 +3,8 @@ package lambda
 import (
 	"context"
 	"fmt"
+	"maps"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -21,17 +23,47 @@ func (l *lambdaFunctionResourceActions) Create(
 		return nil, err
 	}
 
+	err = checkNewFunctionRuntime(
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	codePackage, err := l.prepareFunctionCode(
+		ctx,
+		input.ProviderContext,
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := l.resolveFunctionImage(
+		ctx,
+		input.ProviderContext,
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	createOperations := []pluginutils.SaveOperation[Service]{
 		&functionCreate{},
 		&functionConcurrencyUpdate{},
 		&functionRecursionConfigUpdate{},
 		&functionRuntimeManagementConfigUpdate{},
+		&functionSnapStartVersionPublish{},
 	}
 
 	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
 		ctx,
 		pluginutils.SaveOperationContext{
-			Data: map[string]any{},
+			Data: map[string]any{
+				"functionCodePackage": codePackage,
+				"functionImage":       image,
+			},
 		},
 		createOperations,
 		input,
@@ -54,6 +86,16 @@ func (l *lambdaFunctionResourceActions) Create(
 		"spec.arn": core.MappingNodeFromString(aws.ToString(createFunctionOutput.FunctionArn)),
 	}
 
+	if aws.ToString(createFunctionOutput.CodeSha256) != "" {
+		computedFields["spec.codeSha256"] = core.MappingNodeFromString(
+			aws.ToString(createFunctionOutput.CodeSha256),
+		)
+	}
+
+	if image != nil {
+		computedFields["spec.imageDigest"] = core.MappingNodeFromString(image.digest)
+	}
+
 	if createFunctionOutput.SnapStart != nil {
 		computedFields["spec.snapStartResponseApplyOn"] = core.MappingNodeFromString(
 			string(createFunctionOutput.SnapStart.ApplyOn),
@@ -63,6 +105,11 @@ func (l *lambdaFunctionResourceActions) Create(
 		)
 	}
 
+	publishedVersion, hasPublishedVersion := saveOpCtx.Data["publishedFunctionVersion"].(*publishedFunctionVersion)
+	if hasPublishedVersion {
+		maps.Copy(computedFields, publishedVersionComputedFields(publishedVersion))
+	}
+
 	return &provider.ResourceDeployOutput{
 		ComputedFieldValues: computedFields,
 	}, nil
@@ -70,6 +117,8 @@ func (l *lambdaFunctionResourceActions) Create(
 
 func changesToCreateFunctionInput(
 	specData *core.MappingNode,
+	codePackage *functionCodePackage,
+	image *functionImage,
 ) (*lambda.CreateFunctionInput, bool, error) {
 	input := &lambda.CreateFunctionInput{}
 
@@ -174,6 +223,19 @@ func changesToCreateFunctionInput(
 		hasUpdates = hasUpdates || valueSetter.DidSet()
 	}
 
+	if codePackage != nil {
+		if input.Code == nil {
+			input.Code = &types.FunctionCode{}
+		}
+		codePackage.applyToFunctionCode(input.Code)
+	}
+
+	// Images are deployed by digest so the image that is deployed
+	// matches the digest recorded for the function.
+	if image != nil && input.Code != nil {
+		input.Code.ImageUri = aws.String(image.pinnedURI)
+	}
+
 	return input, hasUpdates, nil
 }
 
@@ -223,12 +285,6 @@ func setCreateFunctionCode(
 				target.SourceKMSKeyArn = aws.String(core.StringValue(value))
 			},
 		),
-		pluginutils.NewValueSetter(
-			"$.zipFile",
-			func(value *core.MappingNode, target *types.FunctionCode) {
-				target.ZipFile = []byte(core.StringValue(value))
-			},
-		),
 	}
 
 	for _, valueSetter := range valueSetters {
@@ -23,8 +23,12 @@ func (u *functionCreate) Prepare(
 	specData *core.MappingNode,
 	changes *provider.Changes,
 ) (bool, pluginutils.SaveOperationContext, error) {
+	codePackage, _ := saveOpCtx.Data["functionCodePackage"].(*functionCodePackage)
+	image, _ := saveOpCtx.Data["functionImage"].(*functionImage)
 	input, hasValues, err := changesToCreateFunctionInput(
 		specData,
+		codePackage,
+		image,
 	)
 	if err != nil {
 		return false, saveOpCtx, err
@@ -51,6 +55,7 @@ func (u *functionCreate) Execute(
 		createFunctionOutput.FunctionArn,
 	)
 	newSaveOpCtx.Data["createFunctionOutput"] = createFunctionOutput
+	markFunctionVersionChanged(newSaveOpCtx)
 
 	return newSaveOpCtx, err
 }
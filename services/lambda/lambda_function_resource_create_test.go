@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -21,6 +22,9 @@ type LambdaFunctionResourceCreateSuite struct {
 }
 
 func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function() {
+	codePackage, err := packageFunctionCodeFromPath(testFunctionCodePath, "")
+	s.Require().NoError(err)
+
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -40,11 +44,13 @@ func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function() {
 		createFunctionWithMultipleConfigsTestCase(providerCtx, loader),
 		createFunctionWithAdvancedConfigsTestCase(providerCtx, loader),
 		createFunctionWithAllCodeSourceFieldsTestCase(providerCtx, loader),
+		createFunctionWithCodePathTestCase(providerCtx, loader, codePackage),
+		createFunctionWithInlineCodeFilesTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		FunctionResource,
+		functionResourceFactory(createS3ServiceMock(), createTestImageECRServiceMock(testImageDigest)),
 		&s.Suite,
 	)
 }
@@ -68,7 +74,7 @@ func createBasicFunctionCreateTestCase(
 			"description":  core.MappingNodeFromString("Test function"),
 			"memorySize":   core.MappingNodeFromInt(128),
 			"timeout":      core.MappingNodeFromInt(3),
-			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
 			"handler":      core.MappingNodeFromString("index.handler"),
 			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
 			"code": {
@@ -145,11 +151,11 @@ func createBasicFunctionCreateTestCase(
 				Description:  aws.String("Test function"),
 				MemorySize:   aws.Int32(128),
 				Timeout:      aws.Int32(3),
-				Runtime:      types.Runtime("nodejs18.x"),
+				Runtime:      types.Runtime("nodejs22.x"),
 				Handler:      aws.String("index.handler"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ZipFile: []byte("console.log('Hello, World!');"),
+					ZipFile: inlineFunctionCodeZip("index.js", "console.log('Hello, World!');"),
 				},
 			},
 		},
@@ -175,7 +181,7 @@ func createFunctionWithTagsTestCase(
 			"description":  core.MappingNodeFromString("Test function"),
 			"memorySize":   core.MappingNodeFromInt(128),
 			"timeout":      core.MappingNodeFromInt(3),
-			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
 			"handler":      core.MappingNodeFromString("index.handler"),
 			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
 			"code": {
@@ -271,11 +277,11 @@ func createFunctionWithTagsTestCase(
 				Description:  aws.String("Test function"),
 				MemorySize:   aws.Int32(128),
 				Timeout:      aws.Int32(3),
-				Runtime:      types.Runtime("nodejs18.x"),
+				Runtime:      types.Runtime("nodejs22.x"),
 				Handler:      aws.String("index.handler"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ZipFile: []byte("console.log('Hello, World!');"),
+					ZipFile: inlineFunctionCodeZip("index.js", "console.log('Hello, World!');"),
 				},
 				Tags: map[string]string{
 					"Environment": "test",
@@ -300,6 +306,16 @@ func createFunctionWithSnapStartTestCase(
 				OptimizationStatus: types.SnapStartOptimizationStatusOn,
 			},
 		}),
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				State:       types.StateActive,
+			},
+		}),
+		WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(resourceARN + ":1"),
+			Version:     aws.String("1"),
+		}),
 	)
 
 	// Create test data for function creation with SnapStart
@@ -309,7 +325,7 @@ func createFunctionWithSnapStartTestCase(
 			"description":  core.MappingNodeFromString("Test function"),
 			"memorySize":   core.MappingNodeFromInt(128),
 			"timeout":      core.MappingNodeFromInt(3),
-			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
 			"handler":      core.MappingNodeFromString("index.handler"),
 			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
 			"code": {
@@ -392,6 +408,8 @@ func createFunctionWithSnapStartTestCase(
 				"spec.snapStartResponseOptimizationStatus": core.MappingNodeFromString(
 					string(types.SnapStartOptimizationStatusOn),
 				),
+				"spec.publishedVersion":    core.MappingNodeFromString("1"),
+				"spec.publishedVersionArn": core.MappingNodeFromString(resourceARN + ":1"),
 			},
 		},
 		SaveActionsCalled: map[string]any{
@@ -400,16 +418,19 @@ func createFunctionWithSnapStartTestCase(
 				Description:  aws.String("Test function"),
 				MemorySize:   aws.Int32(128),
 				Timeout:      aws.Int32(3),
-				Runtime:      types.Runtime("nodejs18.x"),
+				Runtime:      types.Runtime("nodejs22.x"),
 				Handler:      aws.String("index.handler"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ZipFile: []byte("console.log('Hello, World!');"),
+					ZipFile: inlineFunctionCodeZip("index.js", "console.log('Hello, World!');"),
 				},
 				SnapStart: &types.SnapStart{
 					ApplyOn: types.SnapStartApplyOnPublishedVersions,
 				},
 			},
+			"PublishVersion": &lambda.PublishVersionInput{
+				FunctionName: aws.String(resourceARN),
+			},
 		},
 	}
 }
@@ -429,7 +450,7 @@ func createFunctionFailureTestCase(
 			"description":  core.MappingNodeFromString("Test function"),
 			"memorySize":   core.MappingNodeFromInt(128),
 			"timeout":      core.MappingNodeFromInt(3),
-			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
 			"handler":      core.MappingNodeFromString("index.handler"),
 			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
 			"code": {
@@ -502,11 +523,11 @@ func createFunctionFailureTestCase(
 				Description:  aws.String("Test function"),
 				MemorySize:   aws.Int32(128),
 				Timeout:      aws.Int32(3),
-				Runtime:      types.Runtime("nodejs18.x"),
+				Runtime:      types.Runtime("nodejs22.x"),
 				Handler:      aws.String("index.handler"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ZipFile: []byte("console.log('Hello, World!');"),
+					ZipFile: inlineFunctionCodeZip("index.js", "console.log('Hello, World!');"),
 				},
 			},
 		},
@@ -536,7 +557,7 @@ func createFunctionWithMultipleConfigsTestCase(
 			"description":  core.MappingNodeFromString("Test function with all configs"),
 			"memorySize":   core.MappingNodeFromInt(128),
 			"timeout":      core.MappingNodeFromInt(3),
-			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
 			"handler":      core.MappingNodeFromString("index.handler"),
 			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
 			"code": {
@@ -552,7 +573,7 @@ func createFunctionWithMultipleConfigsTestCase(
 			"runtimeManagementConfig": {
 				Fields: map[string]*core.MappingNode{
 					"updateRuntimeOn":   core.MappingNodeFromString("Manual"),
-					"runtimeVersionArn": core.MappingNodeFromString("arn:aws:lambda:us-west-2::runtime:nodejs18.x"),
+					"runtimeVersionArn": core.MappingNodeFromString("arn:aws:lambda:us-west-2::runtime:nodejs22.x"),
 				},
 			},
 		},
@@ -639,11 +660,11 @@ func createFunctionWithMultipleConfigsTestCase(
 				Description:  aws.String("Test function with all configs"),
 				MemorySize:   aws.Int32(128),
 				Timeout:      aws.Int32(3),
-				Runtime:      types.Runtime("nodejs18.x"),
+				Runtime:      types.Runtime("nodejs22.x"),
 				Handler:      aws.String("index.handler"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ZipFile: []byte("exports.handler = async (event) => { return { statusCode: 200, body: 'Hello from Lambda!' }; };"),
+					ZipFile: inlineFunctionCodeZip("index.js", "exports.handler = async (event) => { return { statusCode: 200, body: 'Hello from Lambda!' }; };"),
 				},
 				CodeSigningConfigArn: aws.String(
 					"arn:aws:lambda:us-west-2:123456789012:code-signing-config:csc-12345678901234567",
@@ -660,7 +681,7 @@ func createFunctionWithMultipleConfigsTestCase(
 			"PutRuntimeManagementConfig": &lambda.PutRuntimeManagementConfigInput{
 				FunctionName:      aws.String("arn:aws:lambda:us-west-2:123456789012:function:test-function"),
 				UpdateRuntimeOn:   types.UpdateRuntimeOnManual,
-				RuntimeVersionArn: aws.String("arn:aws:lambda:us-west-2::runtime:nodejs18.x"),
+				RuntimeVersionArn: aws.String("arn:aws:lambda:us-west-2::runtime:nodejs22.x"),
 			},
 		},
 	}
@@ -690,7 +711,7 @@ func createFunctionWithAdvancedConfigsTestCase(
 			"description":  core.MappingNodeFromString("Test function with all configurations"),
 			"memorySize":   core.MappingNodeFromInt(512),
 			"timeout":      core.MappingNodeFromInt(30),
-			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
 			"handler":      core.MappingNodeFromString("index.handler"),
 			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
 			"code": {
@@ -798,11 +819,11 @@ func createFunctionWithAdvancedConfigsTestCase(
 				Description:  aws.String("Test function with all configurations"),
 				MemorySize:   aws.Int32(512),
 				Timeout:      aws.Int32(30),
-				Runtime:      types.Runtime("nodejs18.x"),
+				Runtime:      types.Runtime("nodejs22.x"),
 				Handler:      aws.String("index.handler"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ZipFile: []byte("exports.handler = async (event) => { return { statusCode: 200, body: 'Hello World!' }; };"),
+					ZipFile: inlineFunctionCodeZip("index.js", "exports.handler = async (event) => { return { statusCode: 200, body: 'Hello World!' }; };"),
 				},
 				TracingConfig: &types.TracingConfig{
 					Mode: types.TracingMode("Active"),
@@ -874,7 +895,7 @@ func createFunctionWithAllCodeSourceFieldsTestCase(
 				},
 			},
 			"handler":    core.MappingNodeFromString("index.handler"),
-			"runtime":    core.MappingNodeFromString("nodejs18.x"),
+			"runtime":    core.MappingNodeFromString("nodejs22.x"),
 			"memorySize": core.MappingNodeFromInt(512),
 			"timeout":    core.MappingNodeFromInt(30),
 			"layers": core.MappingNodeFromStringSlice([]string{
@@ -949,7 +970,8 @@ func createFunctionWithAllCodeSourceFieldsTestCase(
 		},
 		ExpectedOutput: &provider.ResourceDeployOutput{
 			ComputedFieldValues: map[string]*core.MappingNode{
-				"spec.arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+				"spec.arn":         core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+				"spec.imageDigest": core.MappingNodeFromString(testImageDigest),
 			},
 		},
 		SaveActionsCalled: map[string]any{
@@ -957,14 +979,15 @@ func createFunctionWithAllCodeSourceFieldsTestCase(
 				FunctionName: aws.String("test-function"),
 				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
 				Code: &types.FunctionCode{
-					ImageUri:        aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image:latest"),
+					// The image tag is resolved to a digest before the function is created.
+					ImageUri:        aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image@" + testImageDigest),
 					S3Bucket:        aws.String("test-bucket"),
 					S3Key:           aws.String("test-key"),
 					S3ObjectVersion: aws.String("test-version"),
 					SourceKMSKeyArn: aws.String("arn:aws:kms:us-east-1:123456789012:key/test-key"),
 				},
 				Handler:    aws.String("index.handler"),
-				Runtime:    types.Runtime("nodejs18.x"),
+				Runtime:    types.Runtime("nodejs22.x"),
 				MemorySize: aws.Int32(512),
 				Timeout:    aws.Int32(30),
 				Layers: []string{
@@ -978,6 +1001,392 @@ func createFunctionWithAllCodeSourceFieldsTestCase(
 	}
 }
 
+func createFunctionWithCodePathTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	codePackage *functionCodePackage,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(resourceARN),
+			CodeSha256:  aws.String(codePackage.sha256),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create function with code packaged from a local path",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.runtime",
+					},
+					{
+						FieldPath: "spec.handler",
+					},
+					{
+						FieldPath: "spec.role",
+					},
+					{
+						FieldPath: "spec.code",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(codePackage.sha256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateFunction": &lambda.CreateFunctionInput{
+				FunctionName: aws.String("test-function"),
+				Runtime:      types.RuntimeNodejs22x,
+				Handler:      aws.String("index.handler"),
+				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
+				Code: &types.FunctionCode{
+					ZipFile: codePackage.zipFile,
+				},
+			},
+		},
+	}
+}
+
+func createFunctionWithInlineCodeFilesTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	zipFile, err := utils.ZipFilesInMemory([]utils.ArchiveFile{
+		{
+			Name:    "index.js",
+			Content: []byte("const { greet } = require('./lib/greet');"),
+		},
+		{
+			Name:    "lib/greet.js",
+			Content: []byte("exports.greet = (name) => `Hello, ${name}!`;"),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	service := createLambdaServiceMock(
+		WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(resourceARN),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs22.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"zipFile": core.MappingNodeFromString("const { greet } = require('./lib/greet');"),
+					"files": {
+						Fields: map[string]*core.MappingNode{
+							"lib/greet.js": core.MappingNodeFromString("exports.greet = (name) => `Hello, ${name}!`;"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create function with inline code files",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.runtime",
+					},
+					{
+						FieldPath: "spec.handler",
+					},
+					{
+						FieldPath: "spec.role",
+					},
+					{
+						FieldPath: "spec.code",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(resourceARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateFunction": &lambda.CreateFunctionInput{
+				FunctionName: aws.String("test-function"),
+				Runtime:      types.RuntimeNodejs22x,
+				Handler:      aws.String("index.handler"),
+				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
+				Code: &types.FunctionCode{
+					ZipFile: zipFile,
+				},
+			},
+		},
+	}
+}
+
+func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function_fails_for_code_path_with_zip_file() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+	service := createLambdaServiceMock()
+
+	resource := functionResourceFactory(createS3ServiceMock(), createECRServiceMock())(
+		func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+	)
+
+	_, err := resource.Deploy(
+		context.Background(),
+		&provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("test-function"),
+								"code": {
+									Fields: map[string]*core.MappingNode{
+										"path":    core.MappingNodeFromString(testFunctionCodePath),
+										"zipFile": core.MappingNodeFromString("console.log('Hello, World!');"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "code.path can not be used in combination with code.zipFile")
+}
+
+func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function_fails_for_create_blocked_runtime() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+	service := createLambdaServiceMock()
+
+	resource := functionResourceFactory(createS3ServiceMock(), createECRServiceMock())(
+		func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+	)
+
+	_, err := resource.Deploy(
+		context.Background(),
+		&provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("test-function"),
+								"runtime":      core.MappingNodeFromString("nodejs16.x"),
+								"handler":      core.MappingNodeFromString("index.handler"),
+								"code": {
+									Fields: map[string]*core.MappingNode{
+										"path": core.MappingNodeFromString(testFunctionCodePath),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "Lambda no longer allows")
+}
+
+func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function_fails_for_inline_code_file_outside_of_package_root() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+	service := createLambdaServiceMock()
+
+	resource := functionResourceFactory(createS3ServiceMock(), createECRServiceMock())(
+		func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+	)
+
+	_, err := resource.Deploy(
+		context.Background(),
+		&provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("test-function"),
+								"runtime":      core.MappingNodeFromString("nodejs22.x"),
+								"code": {
+									Fields: map[string]*core.MappingNode{
+										"files": {
+											Fields: map[string]*core.MappingNode{
+												"../outside.js": core.MappingNodeFromString("module.exports = {};"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "resolves to a location outside of the archive root")
+}
+
 func TestLambdaFunctionResourceCreate(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceCreateSuite))
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
 )
 
 func (l *lambdaFunctionResourceActions) Destroy(
@@ -26,6 +27,27 @@ func (l *lambdaFunctionResourceActions) Destroy(
 			FunctionName: &functionARN,
 		},
 	)
+	if err != nil {
+		// A function that has already been deleted outside of the blueprint
+		// should not prevent the rest of the blueprint from being destroyed.
+		if isFunctionNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	waitForDeletion, _ := pluginutils.GetValueByPath(
+		"$.waitForDeletion",
+		input.ResourceState.SpecData,
+	)
+	if core.BoolValue(waitForDeletion) {
+		return waitForFunctionToBeDeleted(
+			ctx,
+			lambdaService,
+			functionARN,
+			defaultFunctionDeletionWaitConfig,
+		)
+	}
 
-	return err
+	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
 	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
@@ -34,11 +35,47 @@ func (s *LambdaFunctionResourceDestroySuite) Test_destroy() {
 	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
 		createSuccesfulDestroyTestCase(providerCtx, loader),
 		createFailingDestroyTestCase(providerCtx, loader),
+		createFunctionDestroyTestCase(
+			"treats function that has already been deleted as destroyed",
+			createLambdaServiceMock(
+				WithDeleteFunctionError(&types.ResourceNotFoundException{
+					Message: aws.String("Function not found"),
+				}),
+			),
+			false,
+			false,
+			providerCtx,
+			loader,
+		),
+		createFunctionDestroyTestCase(
+			"waits for function to be deleted",
+			createLambdaServiceMock(
+				WithDeleteFunctionOutput(&lambda.DeleteFunctionOutput{}),
+				WithGetFunctionError(&types.ResourceNotFoundException{
+					Message: aws.String("Function not found"),
+				}),
+			),
+			true,
+			false,
+			providerCtx,
+			loader,
+		),
+		createFunctionDestroyTestCase(
+			"fails when checking whether function has been deleted fails",
+			createLambdaServiceMock(
+				WithDeleteFunctionOutput(&lambda.DeleteFunctionOutput{}),
+				WithGetFunctionError(errors.New("access denied")),
+			),
+			true,
+			true,
+			providerCtx,
+			loader,
+		),
 	}
 
 	plugintestutils.RunResourceDestroyTestCases(
 		testCases,
-		FunctionResource,
+		functionResourceFactory(createS3ServiceMock(), createECRServiceMock()),
 		&s.Suite,
 	)
 }
@@ -113,6 +150,42 @@ func createFailingDestroyTestCase(
 	}
 }
 
+func createFunctionDestroyTestCase(
+	name string,
+	service *lambdaServiceMock,
+	waitForDeletion bool,
+	expectError bool,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+						"waitForDeletion": core.MappingNodeFromBool(waitForDeletion),
+					},
+				},
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
 func TestLambdaFunctionResourceDestroySuite(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceDestroySuite))
 }
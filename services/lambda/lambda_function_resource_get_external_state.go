@@ -2,14 +2,17 @@ package lambda
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
 )
 
 type optionalConfiguration struct {
@@ -32,23 +35,40 @@ func (l *lambdaFunctionResourceActions) GetExternalState(
 		return nil, err
 	}
 
-	functionARN := core.StringValue(
-		input.CurrentResourceSpec.Fields["arn"],
-	)
+	functionIdentifier, err := functionIdentifierFromSpec(input.CurrentResourceSpec)
+	if err != nil {
+		return nil, err
+	}
 
 	functionOutput, err := lambdaService.GetFunction(
 		ctx,
 		&lambda.GetFunctionInput{
-			FunctionName: &functionARN,
+			FunctionName: &functionIdentifier,
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// The identifier used to look up the function can be a name or partial ARN
+	// when importing an existing function, the full ARN from the response
+	// is used for all subsequent lookups and as the ID of the resource.
+	functionARN := aws.ToString(functionOutput.Configuration.FunctionArn)
+
+	currentImage, err := l.resolveExternalStateFunctionImage(
+		ctx,
+		input.ProviderContext,
+		functionOutput,
+		input.CurrentResourceSpec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	resourceSpecState := l.buildBaseResourceSpecState(
 		functionOutput,
 		input.CurrentResourceSpec.Fields["code"],
+		currentImage,
 	)
 
 	err = l.addOptionalConfigurationsToSpec(
@@ -71,14 +91,89 @@ func (l *lambdaFunctionResourceActions) GetExternalState(
 
 	l.addComputedFieldsToSpec(functionOutput, resourceSpecState.Fields)
 
+	// waitForDeletion only determines the behaviour of the provider
+	// when destroying the function so is not stored in AWS.
+	if waitForDeletion, hasValue := pluginutils.GetValueByPath(
+		"$.waitForDeletion",
+		input.CurrentResourceSpec,
+	); hasValue {
+		resourceSpecState.Fields["waitForDeletion"] = waitForDeletion
+	}
+
+	// The version published for SnapStart is tracked by the provider,
+	// the function configuration only includes the unpublished version.
+	if isSnapStartEnabled(resourceSpecState) {
+		for _, field := range []string{"publishedVersion", "publishedVersionArn"} {
+			if value, hasValue := pluginutils.GetValueByPath(
+				"$."+field,
+				input.CurrentResourceSpec,
+			); hasValue {
+				resourceSpecState.Fields[field] = value
+			}
+		}
+	}
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: resourceSpecState,
 	}, nil
 }
 
+// functionIdentifierFromSpec resolves the identifier used to look up
+// a function from the current resource spec.
+// The arn field is used for functions that are already managed by
+// a blueprint, when importing an existing function that is not yet
+// managed by a blueprint, the function name or partial ARN provided
+// in the functionName field is used instead.
+func functionIdentifierFromSpec(specData *core.MappingNode) (string, error) {
+	arn, hasARN := pluginutils.GetValueByPath("$.arn", specData)
+	if hasARN && core.StringValue(arn) != "" {
+		return core.StringValue(arn), nil
+	}
+
+	functionName, hasFunctionName := pluginutils.GetValueByPath("$.functionName", specData)
+	if hasFunctionName && core.StringValue(functionName) != "" {
+		return core.StringValue(functionName), nil
+	}
+
+	return "", fmt.Errorf(
+		"either the arn or functionName field must be set to retrieve the external state of a function",
+	)
+}
+
+// resolveExternalStateFunctionImage resolves the image that the tag in the
+// code.imageUri field of the current spec points to for a function
+// deployed from a container image.
+// This returns nil if the function is not deployed from an image,
+// the spec does not refer to an image in Amazon ECR or the tag or
+// repository no longer exists, in which case the deployed image URI
+// is reported so the change is surfaced as drift.
+func (l *lambdaFunctionResourceActions) resolveExternalStateFunctionImage(
+	ctx context.Context,
+	providerContext provider.Context,
+	functionOutput *lambda.GetFunctionOutput,
+	currentResourceSpec *core.MappingNode,
+) (*functionImage, error) {
+	if functionOutput.Code == nil || functionOutput.Code.ImageUri == nil {
+		return nil, nil
+	}
+
+	image, err := l.resolveFunctionImage(ctx, providerContext, currentResourceSpec)
+	if err != nil {
+		var imageNotFoundErr *ecrtypes.ImageNotFoundException
+		var repositoryNotFoundErr *ecrtypes.RepositoryNotFoundException
+		if errors.As(err, &imageNotFoundErr) || errors.As(err, &repositoryNotFoundErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return image, nil
+}
+
 func (l *lambdaFunctionResourceActions) buildBaseResourceSpecState(
 	functionOutput *lambda.GetFunctionOutput,
 	inputSpecCode *core.MappingNode,
+	currentImage *functionImage,
 ) *core.MappingNode {
 	return &core.MappingNode{
 		Fields: map[string]*core.MappingNode{
@@ -91,6 +186,7 @@ func (l *lambdaFunctionResourceActions) buildBaseResourceSpecState(
 			"code": functionCodeConfigToMappingNode(
 				functionOutput.Code,
 				inputSpecCode,
+				currentImage,
 			),
 			"functionName": core.MappingNodeFromString(
 				aws.ToString(functionOutput.Configuration.FunctionName),
@@ -289,6 +385,19 @@ func (l *lambdaFunctionResourceActions) addComputedFieldsToSpec(
 		aws.ToString(functionOutput.Configuration.FunctionArn),
 	)
 
+	if aws.ToString(functionOutput.Configuration.CodeSha256) != "" {
+		specFields["codeSha256"] = core.MappingNodeFromString(
+			aws.ToString(functionOutput.Configuration.CodeSha256),
+		)
+	}
+
+	if functionOutput.Code != nil {
+		imageDigest := imageDigestFromURI(aws.ToString(functionOutput.Code.ResolvedImageUri))
+		if imageDigest != "" {
+			specFields["imageDigest"] = core.MappingNodeFromString(imageDigest)
+		}
+	}
+
 	if functionOutput.Configuration.SnapStart != nil {
 		specFields["snapStartResponseApplyOn"] = core.MappingNodeFromString(
 			string(functionOutput.Configuration.SnapStart.ApplyOn),
@@ -377,6 +486,7 @@ func (l *lambdaFunctionResourceActions) addConcurrencyConfigToSpec(
 func functionCodeConfigToMappingNode(
 	code *types.FunctionCodeLocation,
 	inputSpecCode *core.MappingNode,
+	currentImage *functionImage,
 ) *core.MappingNode {
 	fields := map[string]*core.MappingNode{}
 
@@ -398,10 +508,36 @@ func functionCodeConfigToMappingNode(
 		if zipFile, hasZipFile := inputSpecCode.Fields["zipFile"]; hasZipFile {
 			fields["zipFile"] = zipFile
 		}
+		if files, hasFiles := inputSpecCode.Fields["files"]; hasFiles {
+			fields["files"] = files
+		}
+		if path, hasPath := inputSpecCode.Fields["path"]; hasPath {
+			fields["path"] = path
+		}
+		if stagingBucket, hasStagingBucket := inputSpecCode.Fields["stagingBucket"]; hasStagingBucket {
+			fields["stagingBucket"] = stagingBucket
+		}
 	}
 
 	if code.ImageUri != nil {
 		fields["imageUri"] = core.MappingNodeFromString(aws.ToString(code.ImageUri))
+		// Images are deployed by the digest that the tag in the spec resolved to,
+		// the tagged URI from the input spec is kept when the deployed image is from
+		// the same repository and the tag still points to the deployed image,
+		// so the pinned digest is not reported as drift.
+		// When the tag has been moved to a different image, the URI of the
+		// deployed image is reported so the change is surfaced as drift.
+		if inputSpecCode != nil && currentImage != nil {
+			inputImageURI, hasInputImageURI := inputSpecCode.Fields["imageUri"]
+			if hasInputImageURI &&
+				isSameImageRepository(
+					core.StringValue(inputImageURI),
+					aws.ToString(code.ImageUri),
+				) &&
+				currentImage.digest == deployedImageDigest(code) {
+				fields["imageUri"] = inputImageURI
+			}
+		}
 	}
 
 	if code.SourceKMSKeyArn != nil {
@@ -411,6 +547,18 @@ func functionCodeConfigToMappingNode(
 	return &core.MappingNode{Fields: fields}
 }
 
+// deployedImageDigest returns the digest of the image deployed for a function,
+// the resolved image URI is always pinned to a digest, the image URI
+// only includes a digest when the function was deployed with a pinned URI.
+func deployedImageDigest(code *types.FunctionCodeLocation) string {
+	digest := imageDigestFromURI(aws.ToString(code.ResolvedImageUri))
+	if digest != "" {
+		return digest
+	}
+
+	return imageDigestFromURI(aws.ToString(code.ImageUri))
+}
+
 func functionDeadLetterConfigToMappingNode(
 	deadLetterConfig *types.DeadLetterConfig,
 ) *core.MappingNode {
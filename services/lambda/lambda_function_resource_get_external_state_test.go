@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
@@ -40,15 +41,96 @@ func (s *LambdaFunctionResourceGetExternalStateSuite) Test_get_external_state()
 		createEphemeralStorageTestCase(providerCtx, loader),
 		createImageConfigTestCase(providerCtx, loader),
 		createTracingAndRuntimeVersionTestCase(providerCtx, loader),
+		createImportFunctionTestCase(
+			"imports existing function by name",
+			"test-function",
+			providerCtx,
+			loader,
+		),
+		createImportFunctionTestCase(
+			"imports existing function by partial ARN",
+			"123456789012:function:test-function",
+			providerCtx,
+			loader,
+		),
+		createMissingFunctionIdentifierTestCase(providerCtx, loader),
+		createPinnedImageStateTestCase(providerCtx, loader),
+		createMovedImageTagStateTestCase(providerCtx, loader),
+		createSnapStartPublishedVersionStateTestCase(providerCtx, loader),
+		createSpecOnlyCodeStateTestCase(
+			"keeps local code path from the input spec",
+			map[string]*core.MappingNode{
+				"path": core.MappingNodeFromString("__testdata/function_code"),
+			},
+			providerCtx,
+			loader,
+		),
+		createSpecOnlyCodeStateTestCase(
+			"keeps inline code files from the input spec",
+			map[string]*core.MappingNode{
+				"zipFile": core.MappingNodeFromString("exports.handler = require('./lib/greet').greet;"),
+				"files": {
+					Fields: map[string]*core.MappingNode{
+						"lib/greet.js": core.MappingNodeFromString("exports.greet = async () => 'Hello';"),
+					},
+				},
+			},
+			providerCtx,
+			loader,
+		),
+		createSpecOnlyCodeStateTestCase(
+			"keeps code staging bucket from the input spec",
+			map[string]*core.MappingNode{
+				"path":          core.MappingNodeFromString("__testdata/function_code"),
+				"stagingBucket": core.MappingNodeFromString("staging-bucket"),
+			},
+			providerCtx,
+			loader,
+		),
 	}
 
 	plugintestutils.RunResourceGetExternalStateTestCases(
 		testCases,
-		FunctionResource,
+		functionResourceFactory(createS3ServiceMock(), createTestImageECRServiceMock(testImageDigest)),
 		&s.Suite,
 	)
 }
 
+func (s *LambdaFunctionResourceGetExternalStateSuite) Test_get_external_state_for_missing_image() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	missingImageErrors := map[string]error{
+		"reports deployed image URI when the image tag no longer exists": &ecrtypes.ImageNotFoundException{
+			Message: aws.String("The image with imageId {imageTag:'latest'} does not exist"),
+		},
+		"reports deployed image URI when the image repository no longer exists": &ecrtypes.RepositoryNotFoundException{
+			Message: aws.String("The repository with name 'test-image' does not exist"),
+		},
+	}
+
+	for name, missingImageErr := range missingImageErrors {
+		plugintestutils.RunResourceGetExternalStateTestCases(
+			[]plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+				createMissingImageStateTestCase(name, providerCtx, loader),
+			},
+			functionResourceFactory(
+				createS3ServiceMock(),
+				createECRServiceMock(WithDescribeImagesError(missingImageErr)),
+			),
+			&s.Suite,
+		)
+	}
+}
+
 func TestLambdaFunctionResourceGetExternalStateSuite(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceGetExternalStateSuite))
 }
@@ -157,6 +239,7 @@ func createAllOptionalConfigsTestCase(
 				"description":  core.MappingNodeFromString("Test function"),
 				"memorySize":   core.MappingNodeFromInt(256),
 				"timeout":      core.MappingNodeFromInt(30),
+				"codeSha256":   core.MappingNodeFromString("YmFzZTY0LWVuY29kZWQtc2hhMjU2"),
 				"environment": {
 					Fields: map[string]*core.MappingNode{
 						"TEST_VAR": core.MappingNodeFromString("test-value"),
@@ -237,6 +320,7 @@ func createAllOptionalConfigsTestCase(
 					Description: aws.String("Test function"),
 					MemorySize:  aws.Int32(256),
 					Timeout:     aws.Int32(30),
+					CodeSha256:  aws.String("YmFzZTY0LWVuY29kZWQtc2hhMjU2"),
 					Environment: &types.EnvironmentResponse{
 						Variables: map[string]string{
 							"TEST_VAR": "test-value",
@@ -310,6 +394,433 @@ func createAllOptionalConfigsTestCase(
 	}
 }
 
+func createImportFunctionTestCase(
+	name string,
+	functionIdentifier string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(createBaseTestFunctionConfig(
+				"test-function",
+				types.RuntimeNodejs18x,
+				"index.handler",
+				"arn:aws:iam::123456789012:role/test-role",
+			)),
+			WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{
+				CodeSigningConfigArn: aws.String(
+					"arn:aws:lambda:us-east-1:123456789012:code-signing-config:csc-1234567890abcdef0",
+				),
+			}),
+			WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{
+				RecursiveLoop: types.RecursiveLoopTerminate,
+			}),
+			WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{
+				ReservedConcurrentExecutions: aws.Int32(10),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString(functionIdentifier),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"runtime":      core.MappingNodeFromString("nodejs18.x"),
+					"handler":      core.MappingNodeFromString("index.handler"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{},
+					},
+					"codeSigningConfigArn": core.MappingNodeFromString(
+						"arn:aws:lambda:us-east-1:123456789012:code-signing-config:csc-1234567890abcdef0",
+					),
+					"recursiveLoop":                core.MappingNodeFromString("Terminate"),
+					"reservedConcurrentExecutions": core.MappingNodeFromInt(10),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createMissingFunctionIdentifierTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "fails when neither arn or functionName is set",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(createBaseTestFunctionConfig(
+				"test-function",
+				types.RuntimeNodejs18x,
+				"index.handler",
+				"arn:aws:iam::123456789012:role/test-role",
+			)),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		ExpectError: true,
+	}
+}
+
+func createPinnedImageStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	pinnedImageURI := "123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image@" + testImageDigest
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		"",
+		"",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.Handler = nil
+	functionOutput.Configuration.PackageType = types.PackageTypeImage
+	functionOutput.Code = &types.FunctionCodeLocation{
+		ImageUri:         aws.String(pinnedImageURI),
+		ResolvedImageUri: aws.String(pinnedImageURI),
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "keeps tagged image URI for image pinned to a digest",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(functionOutput),
+			WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"imageUri": core.MappingNodeFromString(
+								"123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image:latest",
+							),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"packageType":  core.MappingNodeFromString("Image"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"imageUri": core.MappingNodeFromString(
+								"123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image:latest",
+							),
+						},
+					},
+					"imageDigest": core.MappingNodeFromString(testImageDigest),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createMovedImageTagStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	// The tag in the spec has been moved to the image with testImageDigest
+	// since the function was last deployed.
+	deployedDigest := "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	deployedImageURI := "123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image@" + deployedDigest
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		"",
+		"",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.Handler = nil
+	functionOutput.Configuration.PackageType = types.PackageTypeImage
+	functionOutput.Code = &types.FunctionCodeLocation{
+		ImageUri:         aws.String(deployedImageURI),
+		ResolvedImageUri: aws.String(deployedImageURI),
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "reports deployed image URI when the image tag has been moved",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(functionOutput),
+			WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"imageUri": core.MappingNodeFromString(
+								"123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image:latest",
+							),
+						},
+					},
+					"imageDigest": core.MappingNodeFromString(deployedDigest),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"packageType":  core.MappingNodeFromString("Image"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"imageUri": core.MappingNodeFromString(deployedImageURI),
+						},
+					},
+					"imageDigest": core.MappingNodeFromString(deployedDigest),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createMissingImageStateTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	deployedDigest := "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	deployedImageURI := "123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image@" + deployedDigest
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		"",
+		"",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.Handler = nil
+	functionOutput.Configuration.PackageType = types.PackageTypeImage
+	functionOutput.Code = &types.FunctionCodeLocation{
+		ImageUri:         aws.String(deployedImageURI),
+		ResolvedImageUri: aws.String(deployedImageURI),
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(functionOutput),
+			WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"imageUri": core.MappingNodeFromString(
+								"123456789012.dkr.ecr.us-east-1.amazonaws.com/test-image:latest",
+							),
+						},
+					},
+					"imageDigest": core.MappingNodeFromString(deployedDigest),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"packageType":  core.MappingNodeFromString("Image"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"imageUri": core.MappingNodeFromString(deployedImageURI),
+						},
+					},
+					"imageDigest": core.MappingNodeFromString(deployedDigest),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createSnapStartPublishedVersionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-east-1:123456789012:function:test-function"
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		types.RuntimePython313,
+		"index.handler",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.SnapStart = &types.SnapStartResponse{
+		ApplyOn:            types.SnapStartApplyOnPublishedVersions,
+		OptimizationStatus: types.SnapStartOptimizationStatusOff,
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "keeps the version published for SnapStart",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(functionOutput),
+			WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":                 core.MappingNodeFromString(functionARN),
+					"publishedVersion":    core.MappingNodeFromString("2"),
+					"publishedVersionArn": core.MappingNodeFromString(functionARN + ":2"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString(functionARN),
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"handler":      core.MappingNodeFromString("index.handler"),
+					"runtime":      core.MappingNodeFromString("python3.13"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{},
+					},
+					"snapStart": {
+						Fields: map[string]*core.MappingNode{
+							"applyOn": core.MappingNodeFromString("PublishedVersions"),
+						},
+					},
+					"snapStartResponseApplyOn":            core.MappingNodeFromString("PublishedVersions"),
+					"snapStartResponseOptimizationStatus": core.MappingNodeFromString("Off"),
+					"publishedVersion":                    core.MappingNodeFromString("2"),
+					"publishedVersionArn":                 core.MappingNodeFromString(functionARN + ":2"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createSpecOnlyCodeStateTestCase(
+	name string,
+	codeFields map[string]*core.MappingNode,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-east-1:123456789012:function:test-function"
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		types.RuntimeNodejs22x,
+		"index.handler",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionOutput(functionOutput),
+			WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString(functionARN),
+					"code": {
+						Fields: codeFields,
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString(functionARN),
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"handler":      core.MappingNodeFromString("index.handler"),
+					"runtime":      core.MappingNodeFromString("nodejs22.x"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: codeFields,
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
 func createGetFunctionErrorTestCase(
 	providerCtx provider.Context,
 	loader *testutils.MockAWSConfigLoader,
@@ -27,12 +27,50 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 				Label: "FunctionCode",
 				Description: "The code for the Lambda function. You can either specify an object in Amazon S3," +
 					" upload a .zip file archive deployment package directly, or specify the URI of a container image.",
+				ValidateFunc: validateFunctionCodePackageType,
 				Attributes: map[string]*provider.ResourceDefinitionsSchema{
 					"imageUri": {
 						Type:                 provider.ResourceDefinitionsSchemaTypeString,
 						Description:          "The URI of the container image in the Amazon ECR registry.",
 						FormattedDescription: "The URI of a [container image](https://docs.aws.amazon.com/lambda/latest/dg/images-create.html) in the Amazon ECR registry.",
 					},
+					"path": {
+						Type: provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The path to a local directory, file or glob pattern for the source files of the function. " +
+							"Relative paths are resolved against the directory of the blueprint file. " +
+							"The provider will package the matching files into a .zip file archive and upload it directly. " +
+							"Archives larger than 50MB are staged in the Amazon S3 bucket set in stagingBucket " +
+							"or the codeStagingBucket provider config field. " +
+							"The archive is only uploaded when its contents change.",
+						FormattedDescription: "The path to a local directory, file or glob pattern for the source files of the function. " +
+							"Relative paths are resolved against the directory of the blueprint file. " +
+							"The provider will package the matching files into a .zip file archive and upload it directly. " +
+							"Archives larger than 50MB are staged in the Amazon S3 bucket set in `stagingBucket` " +
+							"or the `codeStagingBucket` provider config field. " +
+							"The archive is only uploaded when its contents change.\n\n" +
+							"File names in the archive are relative to the directory or the leading part of the glob pattern " +
+							"that does not contain any wildcards, symbolic links to directories are followed " +
+							"and files matched more than once are only included once. " +
+							"The archive is built with a stable file order, timestamps and permissions so the same files " +
+							"will always produce the same `codeSha256`.",
+						Examples: []*core.MappingNode{
+							core.MappingNodeFromString("./dist"),
+							core.MappingNodeFromString("./src/handlers/*.js"),
+						},
+						MinLength: 1,
+					},
+					"stagingBucket": {
+						Type: provider.ResourceDefinitionsSchemaTypeString,
+						Description: "An Amazon S3 bucket used to stage the deployment package built from the path field " +
+							"when it is too large to be uploaded directly. " +
+							"This overrides the codeStagingBucket provider config field for the function.",
+						FormattedDescription: "An Amazon S3 bucket used to stage the deployment package built from the `path` field " +
+							"when it is too large to be uploaded directly. " +
+							"This overrides the `codeStagingBucket` provider config field for the function.",
+						Pattern:   "^[0-9A-Za-z\\-_][0-9A-Za-z\\.\\-_]+$",
+						MinLength: 3,
+						MaxLength: 63,
+					},
 					"s3Bucket": {
 						Type: provider.ResourceDefinitionsSchemaTypeString,
 						Description: "An Amazon S3 bucket in the same AWS Region as the function. " +
@@ -71,16 +109,41 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 					},
 					"zipFile": {
 						Type: provider.ResourceDefinitionsSchemaTypeString,
-						Description: "(Node.js and Python only) The inline code for the Lambda function. " +
+						Description: "(Node.js, Python, Ruby and custom runtimes only) The inline code for the Lambda function. " +
 							"This will be converted into a base-64 encoded zip archive format by the provider. " +
-							"The zip file will contain a file named \"index\" and cannot exceed 4MB. " +
-							"The handler property in the resource must be of the form \"index.{handlerName}\".",
-						FormattedDescription: "(Node.js and Python only) The inline code for the Lambda function. " +
+							"For Node.js, Python and Ruby, the zip file will contain a file named \"index\" and " +
+							"the handler property in the resource must be of the form \"index.{handlerName}\". " +
+							"For custom runtimes (provided.*), the code will be written to an executable file named \"bootstrap\". " +
+							"The zip file cannot exceed 4MB.",
+						FormattedDescription: "(Node.js, Python, Ruby and custom runtimes only) The inline code for the Lambda function. " +
 							"This will be converted into a base-64 encoded zip archive format by the provider. " +
-							"The zip file will contain a file named `index` and cannot exceed 4MB. " +
-							"The handler property in the resource must be of the form `index.{handlerName}`.",
+							"For Node.js, Python and Ruby, the zip file will contain a file named `index` and " +
+							"the handler property in the resource must be of the form `index.{handlerName}`. " +
+							"For custom runtimes (`provided.*`), the code will be written to an executable file named `bootstrap`. " +
+							"The zip file cannot exceed 4MB.",
 						ValidateFunc: validateZipFileRuntime,
 					},
+					"files": {
+						Type: provider.ResourceDefinitionsSchemaTypeMap,
+						MapValues: &provider.ResourceDefinitionsSchema{
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+						},
+						Description: "A map of relative file paths to inline file contents to include in the deployment package. " +
+							"This can be used on its own or alongside zipFile to add extra modules to the package. " +
+							"File paths must be relative to the root of the package and can not reference parent directories. " +
+							"When used alongside zipFile, a file path can not be the same as the file that zipFile is written to. " +
+							"For custom runtimes (provided.*), only a bootstrap file at the root of the package is marked as executable. " +
+							"The combined zip file cannot exceed 4MB.",
+						FormattedDescription: "A map of relative file paths to inline file contents to include in the deployment package. " +
+							"This can be used on its own or alongside `zipFile` to add extra modules to the package. " +
+							"File paths must be relative to the root of the package and can not reference parent directories. " +
+							"When used alongside `zipFile`, a file path can not be the same as the file that `zipFile` is written to " +
+							"(`index.js`, `index.py`, `index.rb` or `bootstrap` depending on the runtime). " +
+							"For custom runtimes (`provided.*`), only a `bootstrap` file at the root of the package " +
+							"is marked as executable, all other files are added with non-executable permissions (`0644`). " +
+							"The combined zip file cannot exceed 4MB.",
+						ValidateFunc: validateInlineCodeFilePaths,
+					},
 				},
 			},
 			"codeSigningConfigArn": {
@@ -120,10 +183,13 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 						MapValues: &provider.ResourceDefinitionsSchema{
 							Type: provider.ResourceDefinitionsSchemaTypeString,
 						},
-						Description: "Environment variable key-value pairs. Keys must follow the pattern \"[a-zA-Z]([a-zA-Z0-9_])+\".",
+						Description: "Environment variable key-value pairs. Keys must follow the pattern \"[a-zA-Z]([a-zA-Z0-9_])+\" " +
+							"and the total size of all environment variables must not exceed 4 KB.",
 						FormattedDescription: "Environment variable key-value pairs. For more information, see " +
 							"[Using Lambda environment variables](https://docs.aws.amazon.com/lambda/latest/dg/configuration-envvars.html). " +
-							"Keys must follow the pattern `[a-zA-Z]([a-zA-Z0-9_])+`.",
+							"Keys must follow the pattern `[a-zA-Z]([a-zA-Z0-9_])+` " +
+							"and the total size of all environment variables must not exceed 4 KB.",
+						ValidateFunc: validateEnvironmentVariables,
 					},
 				},
 			},
@@ -192,8 +258,9 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 					core.MappingNodeFromString("index.handler"),
 					core.MappingNodeFromString("lambda_function.lambda_handler"),
 				},
-				MaxLength: 128,
-				Pattern:   "^[^\\s]+$",
+				MaxLength:    128,
+				Pattern:      "^[^\\s]+$",
+				ValidateFunc: validateZipPackageTypeField,
 			},
 			"imageConfig": {
 				Type:        provider.ResourceDefinitionsSchemaTypeObject,
@@ -201,6 +268,7 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 				Description: "Configuration values that override the container image Dockerfile settings.",
 				FormattedDescription: "Configuration values that override the container image Dockerfile settings. " +
 					"For more information, see [Container image settings](https://docs.aws.amazon.com/lambda/latest/dg/images-create.html#images-parms)",
+				ValidateFunc: validateImagePackageTypeField,
 				Attributes: map[string]*provider.ResourceDefinitionsSchema{
 					"command": {
 						Type:        provider.ResourceDefinitionsSchemaTypeArray,
@@ -359,50 +427,8 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 					"The following list includes deprecated runtimes. Lambda blocks creating new functions and updating existing functions " +
 					"shortly after each runtime is deprecated. For more information, see [Runtime use after deprecation](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtime-deprecation-levels).\n\n" +
 					"For a list of all currently supported runtimes, see [Supported runtimes](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtimes-supported)",
-				AllowedValues: []*core.MappingNode{
-					core.MappingNodeFromString("nodejs"),
-					core.MappingNodeFromString("nodejs4.3"),
-					core.MappingNodeFromString("nodejs4.3-edge"),
-					core.MappingNodeFromString("nodejs6.10"),
-					core.MappingNodeFromString("nodejs8.10"),
-					core.MappingNodeFromString("nodejs10.x"),
-					core.MappingNodeFromString("nodejs12.x"),
-					core.MappingNodeFromString("nodejs14.x"),
-					core.MappingNodeFromString("nodejs16.x"),
-					core.MappingNodeFromString("nodejs18.x"),
-					core.MappingNodeFromString("nodejs20.x"),
-					core.MappingNodeFromString("nodejs22.x"),
-					core.MappingNodeFromString("java8"),
-					core.MappingNodeFromString("java8.al2"),
-					core.MappingNodeFromString("java11"),
-					core.MappingNodeFromString("java17"),
-					core.MappingNodeFromString("java21"),
-					core.MappingNodeFromString("python2.7"),
-					core.MappingNodeFromString("python3.6"),
-					core.MappingNodeFromString("python3.7"),
-					core.MappingNodeFromString("python3.8"),
-					core.MappingNodeFromString("python3.9"),
-					core.MappingNodeFromString("python3.10"),
-					core.MappingNodeFromString("python3.11"),
-					core.MappingNodeFromString("python3.12"),
-					core.MappingNodeFromString("python3.13"),
-					core.MappingNodeFromString("dotnetcore1.0"),
-					core.MappingNodeFromString("dotnetcore2.0"),
-					core.MappingNodeFromString("dotnetcore2.1"),
-					core.MappingNodeFromString("dotnetcore3.1"),
-					core.MappingNodeFromString("dotnet6"),
-					core.MappingNodeFromString("dotnet7"),
-					core.MappingNodeFromString("dotnet8"),
-					core.MappingNodeFromString("go1.x"),
-					core.MappingNodeFromString("ruby2.5"),
-					core.MappingNodeFromString("ruby2.7"),
-					core.MappingNodeFromString("ruby3.2"),
-					core.MappingNodeFromString("ruby3.3"),
-					core.MappingNodeFromString("ruby3.4"),
-					core.MappingNodeFromString("provided"),
-					core.MappingNodeFromString("provided.al2"),
-					core.MappingNodeFromString("provided.al2023"),
-				},
+				AllowedValues: lambdaRuntimeAllowedValues(),
+				ValidateFunc:  validateRuntime,
 			},
 			"runtimeManagementConfig": {
 				Type:        provider.ResourceDefinitionsSchemaTypeObject,
@@ -451,6 +477,7 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 				Description:          "The function's AWS Lambda SnapStart setting.",
 				FormattedDescription: "The function's [AWS Lambda SnapStart](https://docs.aws.amazon.com/lambda/latest/dg/snapstart.html) setting.",
 				Required:             []string{"applyOn"},
+				ValidateFunc:         validateSnapStartRuntime,
 				Attributes: map[string]*provider.ResourceDefinitionsSchema{
 					"applyOn": {
 						Type:        provider.ResourceDefinitionsSchemaTypeString,
@@ -548,6 +575,15 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 					},
 				},
 			},
+			"waitForDeletion": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether to wait for the function to be fully deleted when it is destroyed. " +
+					"This is useful for functions connected to a VPC, as Lambda keeps hold of the network interfaces " +
+					"in the function's subnets and security groups until the function has been deleted. " +
+					"Waiting for the function to be deleted prevents subnets and security groups in the same " +
+					"blueprint from failing to be destroyed while they are still in use.",
+				Default: core.MappingNodeFromBool(false),
+			},
 
 			// Computed fields
 			"arn": {
@@ -565,6 +601,45 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 				Description: "The status of the SnapStart optimization.",
 				Computed:    true,
 			},
+			"publishedVersion": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The version of the function that was last published for SnapStart. " +
+					"When SnapStart is set to PublishedVersions, a new version is published " +
+					"after changes to the function's code or configuration. " +
+					"This can be used as the functionVersion of an alias to track the latest optimised version.",
+				Computed: true,
+			},
+			"publishedVersionArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the version of the function that was last published " +
+					"for SnapStart.",
+				Computed: true,
+			},
+			"codeSha256": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The base64-encoded SHA-256 hash of the function's deployment package.",
+				Computed:    true,
+			},
+			"imageDigest": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The digest of the container image deployed for a function with the Image package type. " +
+					"Tags in code.imageUri are resolved to a digest when the function is deployed " +
+					"and when the external state of the function is retrieved, tags are not resolved " +
+					"when computing the changes for a blueprint. " +
+					"When a tag has been moved to a different image, or the tag or repository no longer exists, " +
+					"the external state of the function reports the URI of the deployed image in code.imageUri " +
+					"so the change is surfaced as drift, " +
+					"the image the tag points to is deployed as a code change on the next update of the function.",
+				FormattedDescription: "The digest of the container image deployed for a function with the `Image` package type. " +
+					"Tags in `code.imageUri` are resolved to a digest when the function is deployed " +
+					"and when the external state of the function is retrieved, tags are not resolved " +
+					"when computing the changes for a blueprint. " +
+					"When a tag has been moved to a different image, or the tag or repository no longer exists, " +
+					"the external state of the function reports the URI of the deployed image in `code.imageUri` " +
+					"so the change is surfaced as drift, " +
+					"the image the tag points to is deployed as a code change on the next update of the function.",
+				Computed: true,
+			},
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -90,6 +91,95 @@ func (s *LambdaFunctionResourceStabilisedSuite) Test_stabilised() {
 			},
 			ExpectError: false,
 		},
+		{
+			Name: "returns not stabilised when a function update is in progress",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State:            types.StateActive,
+						LastUpdateStatus: types.LastUpdateStatusInProgress,
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+					},
+				},
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "fails when function is in a failed state",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State:           types.StateFailed,
+						StateReasonCode: types.StateReasonCodeEniLimitExceeded,
+						StateReason:     aws.String("Lambda was unable to create an ENI"),
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+					},
+				},
+			},
+			ExpectedOutput: nil,
+			ExpectError:    true,
+		},
+		{
+			Name: "fails when the last function update failed",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State:                      types.StateActive,
+						LastUpdateStatus:           types.LastUpdateStatusFailed,
+						LastUpdateStatusReasonCode: types.LastUpdateStatusReasonCodeInvalidImage,
+						LastUpdateStatusReason:     aws.String("The image manifest could not be read"),
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+					},
+				},
+			},
+			ExpectedOutput: nil,
+			ExpectError:    true,
+		},
 		{
 			Name: "handles get function error",
 			ServiceFactory: createLambdaServiceMockFactory(
@@ -117,11 +207,169 @@ func (s *LambdaFunctionResourceStabilisedSuite) Test_stabilised() {
 
 	plugintestutils.RunResourceHasStabilisedTestCases(
 		testCases,
-		FunctionResource,
+		functionResourceFactory(createS3ServiceMock(), createECRServiceMock()),
 		&s.Suite,
 	)
 }
 
+func (s *LambdaFunctionResourceStabilisedSuite) Test_stabilised_failure_includes_state_reason() {
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	resource := functionResourceFactory(createS3ServiceMock(), createECRServiceMock())(
+		createLambdaServiceMockFactory(
+			WithGetFunctionOutput(&lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					State:           types.StateFailed,
+					StateReasonCode: types.StateReasonCodeEniLimitExceeded,
+					StateReason:     aws.String("Lambda was unable to create an ENI"),
+				},
+			}),
+		),
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			&testutils.MockAWSConfigLoader{},
+		),
+	)
+
+	_, err := resource.HasStabilised(
+		context.Background(),
+		&provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString(
+						"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+					),
+				},
+			},
+		},
+	)
+	s.Require().Error(err)
+
+	deployErr, isDeployErr := err.(*provider.ResourceDeployError)
+	s.Require().True(isDeployErr)
+	s.Assert().Equal(
+		[]string{
+			"function is in a failed state for " +
+				"\"arn:aws:lambda:us-east-1:123456789012:function:test-function\" " +
+				"[EniLimitExceeded]: Lambda was unable to create an ENI",
+		},
+		deployErr.FailureReasons,
+	)
+}
+
+func (s *LambdaFunctionResourceStabilisedSuite) Test_stabilised_waits_for_snap_start_published_version() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, Service]{
+		{
+			Name: "returns not stabilised while the published version is being optimised",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutputSequence(
+					functionWithState(types.StateActive, ""),
+					functionWithState(types.StatePending, types.SnapStartOptimizationStatusOff),
+				),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input:          snapStartStabilisedInput(providerCtx),
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{Stabilised: false},
+		},
+		{
+			Name: "returns stabilised when the published version is optimised",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutputSequence(
+					functionWithState(types.StateActive, ""),
+					functionWithState(types.StateActive, types.SnapStartOptimizationStatusOn),
+				),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input:          snapStartStabilisedInput(providerCtx),
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{Stabilised: true},
+		},
+		{
+			Name: "fails when the published version is in a failed state",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutputSequence(
+					functionWithState(types.StateActive, ""),
+					functionWithState(types.StateFailed, types.SnapStartOptimizationStatusOff),
+				),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input:       snapStartStabilisedInput(providerCtx),
+			ExpectError: true,
+		},
+	}
+
+	plugintestutils.RunResourceHasStabilisedTestCases(
+		testCases,
+		functionResourceFactory(createS3ServiceMock(), createECRServiceMock()),
+		&s.Suite,
+	)
+}
+
+func snapStartStabilisedInput(providerCtx provider.Context) *provider.ResourceHasStabilisedInput {
+	functionARN := "arn:aws:lambda:us-east-1:123456789012:function:test-function"
+	return &provider.ResourceHasStabilisedInput{
+		ProviderContext: providerCtx,
+		ResourceSpec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"arn": core.MappingNodeFromString(functionARN),
+				"snapStart": {
+					Fields: map[string]*core.MappingNode{
+						"applyOn": core.MappingNodeFromString("PublishedVersions"),
+					},
+				},
+				"publishedVersionArn": core.MappingNodeFromString(functionARN + ":2"),
+			},
+		},
+	}
+}
+
+func functionWithState(
+	state types.State,
+	optimizationStatus types.SnapStartOptimizationStatus,
+) *lambda.GetFunctionOutput {
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			State: state,
+			SnapStart: &types.SnapStartResponse{
+				ApplyOn:            types.SnapStartApplyOnPublishedVersions,
+				OptimizationStatus: optimizationStatus,
+			},
+		},
+	}
+}
+
 func TestLambdaFunctionResourceStabilisedSuite(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceStabilisedSuite))
 }
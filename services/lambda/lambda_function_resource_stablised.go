@@ -2,11 +2,14 @@ package lambda
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
 )
 
 func (l *lambdaFunctionResourceActions) Stabilised(
@@ -31,9 +34,118 @@ func (l *lambdaFunctionResourceActions) Stabilised(
 		return nil, err
 	}
 
-	functionState := functionOutput.Configuration.State
-	hasStabilised := functionState == types.StateActive
+	configuration := functionOutput.Configuration
+	if configuration == nil {
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		}, nil
+	}
+
+	// A function that has failed to be created or updated will never become
+	// active without further changes, so a terminal error is returned
+	// with the reason provided by AWS instead of continuing to poll.
+	if configuration.State == types.StateFailed {
+		return nil, functionStabilisationError(
+			functionARN,
+			"function is in a failed state",
+			string(configuration.StateReasonCode),
+			aws.ToString(configuration.StateReason),
+		)
+	}
+
+	if configuration.LastUpdateStatus == types.LastUpdateStatusFailed {
+		return nil, functionStabilisationError(
+			functionARN,
+			"the last update to the function failed",
+			string(configuration.LastUpdateStatusReasonCode),
+			aws.ToString(configuration.LastUpdateStatusReason),
+		)
+	}
+
+	hasStabilised := configuration.State == types.StateActive &&
+		configuration.LastUpdateStatus != types.LastUpdateStatusInProgress
+	if !hasStabilised {
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		}, nil
+	}
+
+	publishedVersionARN, hasPublishedVersion := pluginutils.GetValueByPath(
+		"$.publishedVersionArn",
+		input.ResourceSpec,
+	)
+	if !isSnapStartEnabled(input.ResourceSpec) || !hasPublishedVersion {
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: true,
+		}, nil
+	}
+
+	return publishedVersionHasStabilised(
+		ctx,
+		lambdaService,
+		core.StringValue(publishedVersionARN),
+	)
+}
+
+// publishedVersionHasStabilised checks whether the SnapStart snapshot
+// for a published version of a function is ready to be invoked.
+// Lambda creates the snapshot for a version after it has been published,
+// invocations of the version are not optimised until the optimization
+// status is On.
+func publishedVersionHasStabilised(
+	ctx context.Context,
+	lambdaService Service,
+	versionARN string,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	versionOutput, err := lambdaService.GetFunction(
+		ctx,
+		&lambda.GetFunctionInput{
+			FunctionName: aws.String(versionARN),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := versionOutput.Configuration
+	if configuration == nil {
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		}, nil
+	}
+
+	if configuration.State == types.StateFailed {
+		return nil, functionStabilisationError(
+			versionARN,
+			"published version is in a failed state",
+			string(configuration.StateReasonCode),
+			aws.ToString(configuration.StateReason),
+		)
+	}
+
+	hasStabilised := configuration.State == types.StateActive &&
+		configuration.SnapStart != nil &&
+		configuration.SnapStart.OptimizationStatus == types.SnapStartOptimizationStatusOn
 	return &provider.ResourceHasStabilisedOutput{
 		Stabilised: hasStabilised,
 	}, nil
 }
+
+func functionStabilisationError(
+	functionARN string,
+	summary string,
+	reasonCode string,
+	reason string,
+) error {
+	failureReason := fmt.Sprintf("%s for %q", summary, functionARN)
+	if reasonCode != "" {
+		failureReason = fmt.Sprintf("%s [%s]", failureReason, reasonCode)
+	}
+	if reason != "" {
+		failureReason = fmt.Sprintf("%s: %s", failureReason, reason)
+	}
+
+	return &provider.ResourceDeployError{
+		FailureReasons: []string{failureReason},
+	}
+}
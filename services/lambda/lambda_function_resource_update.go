@@ -3,12 +3,12 @@ package lambda
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
-	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
@@ -36,6 +36,45 @@ func (l *lambdaFunctionResourceActions) Update(
 
 	arn := core.StringValue(arnValue)
 
+	// The blueprint engine should plan a replacement for changes to fields
+	// that can not be updated in place, this guards against partially applying
+	// updates to a function if such changes are ever passed through to an update.
+	err = checkFunctionImmutableFieldChanges(
+		currentStateSpecData,
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	codePackage, err := l.prepareFunctionCode(
+		ctx,
+		input.ProviderContext,
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := l.resolveFunctionImage(
+		ctx,
+		input.ProviderContext,
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s3CodeSha256, err := l.resolveS3FunctionCodeSHA256(
+		ctx,
+		input.ProviderContext,
+		input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec,
+		input.Changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	updateOperations := []pluginutils.SaveOperation[Service]{
 		&functionConfigUpdate{},
 		&functionCodeUpdate{},
@@ -43,16 +82,21 @@ func (l *lambdaFunctionResourceActions) Update(
 		&functionConcurrencyUpdate{},
 		&functionRecursionConfigUpdate{},
 		&functionRuntimeManagementConfigUpdate{},
+		&functionSnapStartVersionPublish{},
 		&tagsUpdate{
 			pathRoot: "$.tags",
 		},
 	}
 
-	hasUpdates, _, err := pluginutils.RunSaveOperations(
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
 		ctx,
 		pluginutils.SaveOperationContext{
 			ProviderUpstreamID: arn,
-			Data:               map[string]any{},
+			Data: map[string]any{
+				"functionCodePackage":  codePackage,
+				"functionImage":        image,
+				"functionCodeS3Sha256": s3CodeSha256,
+			},
 		},
 		updateOperations,
 		input,
@@ -73,6 +117,16 @@ func (l *lambdaFunctionResourceActions) Update(
 		computedFields := l.extractComputedFieldsFromFunctionConfig(
 			getFunctionOutput.Configuration,
 		)
+		if image != nil {
+			computedFields["spec.imageDigest"] = core.MappingNodeFromString(image.digest)
+		}
+
+		if isSnapStartEnabled(input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec) {
+			maps.Copy(
+				computedFields,
+				snapStartPublishedVersionFields(saveOpCtx, currentStateSpecData),
+			)
+		}
 		return &provider.ResourceDeployOutput{
 			ComputedFieldValues: computedFields,
 		}, nil
@@ -87,6 +141,50 @@ func (l *lambdaFunctionResourceActions) Update(
 	}, nil
 }
 
+// Fields of a Lambda function that can not be changed
+// without replacing the function.
+var functionImmutableFields = []struct {
+	path         string
+	defaultValue string
+}{
+	{
+		path: "$.functionName",
+	},
+	{
+		path:         "$.packageType",
+		defaultValue: string(types.PackageTypeZip),
+	},
+}
+
+func checkFunctionImmutableFieldChanges(
+	currentStateSpecData *core.MappingNode,
+	specData *core.MappingNode,
+) error {
+	for _, field := range functionImmutableFields {
+		currentValue := field.defaultValue
+		if value, hasValue := pluginutils.GetValueByPath(field.path, currentStateSpecData); hasValue {
+			currentValue = core.StringValue(value)
+		}
+
+		newValue := field.defaultValue
+		if value, hasValue := pluginutils.GetValueByPath(field.path, specData); hasValue {
+			newValue = core.StringValue(value)
+		}
+
+		if currentValue != newValue {
+			return fmt.Errorf(
+				"the %s field can not be changed from %q to %q for an existing function, "+
+					"the function must be replaced to apply this change",
+				strings.TrimPrefix(field.path, "$."),
+				currentValue,
+				newValue,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (l *lambdaFunctionResourceActions) extractComputedFieldsFromFunctionConfig(
 	functionConfiguration *types.FunctionConfiguration,
 ) map[string]*core.MappingNode {
@@ -115,6 +213,60 @@ func (l *lambdaFunctionResourceActions) extractComputedFieldsFromCurrentState(
 		fields["spec.snapStartResponseOptimizationStatus"] = v
 	}
 
+	if v, ok := pluginutils.GetValueByPath(
+		"$.codeSha256",
+		currentStateSpecData,
+	); ok {
+		fields["spec.codeSha256"] = v
+	}
+
+	if v, ok := pluginutils.GetValueByPath(
+		"$.imageDigest",
+		currentStateSpecData,
+	); ok {
+		fields["spec.imageDigest"] = v
+	}
+
+	maps.Copy(fields, publishedVersionFieldsFromCurrentState(currentStateSpecData))
+
+	return fields
+}
+
+// snapStartPublishedVersionFields returns the computed fields for the version
+// published for a SnapStart-enabled function during an update.
+// Changes that do not affect the code or configuration of the function,
+// such as tags, do not publish a new version so the previously published
+// version is carried over from the current state.
+func snapStartPublishedVersionFields(
+	saveOpCtx pluginutils.SaveOperationContext,
+	currentStateSpecData *core.MappingNode,
+) map[string]*core.MappingNode {
+	publishedVersion, hasPublishedVersion := saveOpCtx.Data["publishedFunctionVersion"].(*publishedFunctionVersion)
+	if hasPublishedVersion {
+		return publishedVersionComputedFields(publishedVersion)
+	}
+
+	return publishedVersionFieldsFromCurrentState(currentStateSpecData)
+}
+
+func publishedVersionFieldsFromCurrentState(
+	currentStateSpecData *core.MappingNode,
+) map[string]*core.MappingNode {
+	fields := map[string]*core.MappingNode{}
+	if v, ok := pluginutils.GetValueByPath(
+		"$.publishedVersion",
+		currentStateSpecData,
+	); ok {
+		fields["spec.publishedVersion"] = v
+	}
+
+	if v, ok := pluginutils.GetValueByPath(
+		"$.publishedVersionArn",
+		currentStateSpecData,
+	); ok {
+		fields["spec.publishedVersionArn"] = v
+	}
+
 	return fields
 }
 
@@ -582,27 +734,18 @@ func changesToUpdateFunctionCodeInput(
 	arn string,
 	updatedSpecData *core.MappingNode,
 	changes *provider.Changes,
+	codePackage *functionCodePackage,
+	image *functionImage,
 ) (*lambda.UpdateFunctionCodeInput, bool, error) {
 	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
 
 	input := &lambda.UpdateFunctionCodeInput{
 		FunctionName: &arn,
-		// Code updates from a change in the blueprint will always be published
-		// for the "current" version of the function represented by the function resource,
-		// versioning is enabled by using the separate function version resources.
-		Publish: true,
-	}
-
-	runtime, _ := pluginutils.GetValueByPath(
-		"$.runtime",
-		updatedSpecData,
-	)
-	err := prepareZipFormatForInlineCode(
-		updatedSpecData,
-		core.StringValue(runtime),
-	)
-	if err != nil {
-		return nil, false, err
+		// Versioning is enabled by using the separate function version resources,
+		// code updates are only published as a part of the update for SnapStart functions
+		// where a published version is needed to create the snapshot of the initialized
+		// execution environment.
+		Publish: isSnapStartEnabled(updatedSpecData),
 	}
 
 	valueSetters := []*pluginutils.ValueSetter[*lambda.UpdateFunctionCodeInput]{
@@ -660,15 +803,6 @@ func changesToUpdateFunctionCodeInput(
 				"spec",
 			),
 		),
-		pluginutils.NewValueSetter(
-			"$.code.zipFile",
-			setUpdateFunctionCodeZipFile,
-			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateFunctionCodeInput](true),
-			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateFunctionCodeInput](
-				modifiedFields,
-				"spec",
-			),
-		),
 	}
 
 	hasUpdates := false
@@ -677,49 +811,41 @@ func changesToUpdateFunctionCodeInput(
 		hasUpdates = hasUpdates || valueSetter.DidSet()
 	}
 
-	return input, hasUpdates, nil
-}
-
-func prepareZipFormatForInlineCode(
-	inputSpecData *core.MappingNode,
-	runtime string,
-) error {
-	zipFile, hasZipFile := pluginutils.GetValueByPath(
-		"$.code.zipFile",
-		inputSpecData,
-	)
-	if !hasZipFile {
-		return nil
-	}
-
-	language := getLanguageFromRuntime(runtime)
-	if language == "python" || language == "nodejs" {
-		extension := getExtensionFromLanguage(language)
-		fileName := fmt.Sprintf("index.%s", extension)
-		zipB64Encoded, err := utils.ZipInMemory(fileName, core.StringValue(zipFile))
-		if err != nil {
-			return err
+	// Code packaged by the provider is always applied to the input as the path
+	// stays the same when the contents of the source files change and the
+	// deployed code can be changed outside of the provider.
+	// The update is skipped when the package matches the hash of the code
+	// that is currently deployed for the function.
+	if codePackage != nil {
+		codePackage.applyToUpdateFunctionCodeInput(input)
+		hasUpdates = true
+	}
+
+	// A tag in the image URI can be moved to a different image without any
+	// changes to the spec, so the resolved digest is compared with the digest
+	// of the last deployed image to detect changes to the image.
+	if image != nil {
+		currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+		currentImageDigest, _ := pluginutils.GetValueByPath(
+			"$.imageDigest",
+			currentStateSpecData,
+		)
+		if input.ImageUri != nil || image.digest != core.StringValue(currentImageDigest) {
+			input.ImageUri = aws.String(image.pinnedURI)
+			hasUpdates = true
 		}
-		inputSpecData.Fields["zipFile"] = core.MappingNodeFromString(zipB64Encoded)
-
-		return nil
 	}
 
-	return fmt.Errorf(
-		"inline code is only supported for Node.js and Python runtimes, "+
-			"the %s runtime can not be used with inline code",
-		runtime,
-	)
+	return input, hasUpdates, nil
 }
 
 func getLanguageFromRuntime(runtime string) string {
-	if strings.HasPrefix(runtime, "nodejs") {
-		return "nodejs"
-	} else if strings.HasPrefix(runtime, "python") {
-		return "python"
+	runtimeInfo, isKnownRuntime := findLambdaRuntime(runtime)
+	if !isKnownRuntime {
+		return ""
 	}
 
-	return ""
+	return runtimeInfo.language
 }
 
 func getExtensionFromLanguage(language string) string {
@@ -728,6 +854,8 @@ func getExtensionFromLanguage(language string) string {
 		return "js"
 	case "python":
 		return "py"
+	case "ruby":
+		return "rb"
 	}
 	return ""
 }
@@ -776,13 +904,6 @@ func setUpdateFunctionCodeSourceKMSKeyARN(
 	input.SourceKMSKeyArn = aws.String(core.StringValue(value))
 }
 
-func setUpdateFunctionCodeZipFile(
-	value *core.MappingNode,
-	input *lambda.UpdateFunctionCodeInput,
-) {
-	input.ZipFile = []byte(core.StringValue(value))
-}
-
 func changesToPutFunctionCodeSigningConfigInput(
 	arn string,
 	updatedSpecData *core.MappingNode,
@@ -843,10 +964,10 @@ func changesToPutRuntimeMgmtConfigInput(
 	arn string,
 	putRuntimeMgmtConfigData *core.MappingNode,
 	changes *provider.Changes,
+	pathRoot string,
 ) (*lambda.PutRuntimeManagementConfigInput, bool) {
 	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
 
-	pathRoot := "spec.runtimeManagementConfig"
 	input := &lambda.PutRuntimeManagementConfigInput{
 		FunctionName: &arn,
 	}
@@ -3,6 +3,7 @@ package lambda
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
 	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
@@ -37,11 +38,22 @@ func (u *functionConfigUpdate) Execute(
 	lambdaService Service,
 ) (pluginutils.SaveOperationContext, error) {
 	_, err := lambdaService.UpdateFunctionConfiguration(ctx, u.input)
-	return saveOpCtx, err
+	if err != nil {
+		return saveOpCtx, functionUpdateError(err)
+	}
+	markFunctionVersionChanged(saveOpCtx)
+
+	return saveOpCtx, waitForFunctionUpdateToComplete(
+		ctx,
+		lambdaService,
+		aws.ToString(u.input.FunctionName),
+		defaultFunctionUpdateWaitConfig,
+	)
 }
 
 type functionCodeUpdate struct {
-	input *lambda.UpdateFunctionCodeInput
+	input      *lambda.UpdateFunctionCodeInput
+	codeSha256 string
 }
 
 func (u *functionCodeUpdate) Name() string {
@@ -53,15 +65,23 @@ func (u *functionCodeUpdate) Prepare(
 	specData *core.MappingNode,
 	changes *provider.Changes,
 ) (bool, pluginutils.SaveOperationContext, error) {
+	codePackage, _ := saveOpCtx.Data["functionCodePackage"].(*functionCodePackage)
+	image, _ := saveOpCtx.Data["functionImage"].(*functionImage)
 	input, hasUpdates, err := changesToUpdateFunctionCodeInput(
 		saveOpCtx.ProviderUpstreamID,
 		specData,
 		changes,
+		codePackage,
+		image,
 	)
 	if err != nil {
 		return false, saveOpCtx, err
 	}
 	u.input = input
+	u.codeSha256 = packagedFunctionCodeSHA256(input, codePackage)
+	if u.codeSha256 == "" {
+		u.codeSha256, _ = saveOpCtx.Data["functionCodeS3Sha256"].(string)
+	}
 	return hasUpdates, saveOpCtx, nil
 }
 
@@ -70,8 +90,46 @@ func (u *functionCodeUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.UpdateFunctionCode(ctx, u.input)
-	return saveOpCtx, err
+	if canSkipIfCodeUnchanged(u.input, u.codeSha256) {
+		unchanged, err := deployedFunctionCodeMatches(
+			ctx,
+			lambdaService,
+			aws.ToString(u.input.FunctionName),
+			u.codeSha256,
+		)
+		if err != nil {
+			return saveOpCtx, err
+		}
+
+		// Skipping the update also avoids publishing a new version
+		// of the function for a package that has already been deployed.
+		if unchanged {
+			return saveOpCtx, nil
+		}
+	}
+
+	updateFunctionCodeOutput, err := lambdaService.UpdateFunctionCode(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, functionUpdateError(err)
+	}
+	markFunctionVersionChanged(saveOpCtx)
+	if updateFunctionCodeOutput != nil {
+		// Code updates for SnapStart functions are published as part of the update,
+		// the published version includes any configuration changes applied
+		// before the code update.
+		recordPublishedFunctionVersion(
+			saveOpCtx,
+			updateFunctionCodeOutput.Version,
+			updateFunctionCodeOutput.FunctionArn,
+		)
+	}
+
+	return saveOpCtx, waitForFunctionUpdateToComplete(
+		ctx,
+		lambdaService,
+		aws.ToString(u.input.FunctionName),
+		defaultFunctionUpdateWaitConfig,
+	)
 }
 
 type functionCodeSigningConfigUpdate struct {
@@ -101,7 +159,7 @@ func (u *functionCodeSigningConfigUpdate) Execute(
 	lambdaService Service,
 ) (pluginutils.SaveOperationContext, error) {
 	_, err := lambdaService.PutFunctionCodeSigningConfig(ctx, u.input)
-	return saveOpCtx, err
+	return saveOpCtx, functionUpdateError(err)
 }
 
 type functionConcurrencyUpdate struct {
@@ -131,7 +189,7 @@ func (u *functionConcurrencyUpdate) Execute(
 	lambdaService Service,
 ) (pluginutils.SaveOperationContext, error) {
 	_, err := lambdaService.PutFunctionConcurrency(ctx, u.input)
-	return saveOpCtx, err
+	return saveOpCtx, functionUpdateError(err)
 }
 
 type functionRecursionConfigUpdate struct {
@@ -161,7 +219,7 @@ func (u *functionRecursionConfigUpdate) Execute(
 	lambdaService Service,
 ) (pluginutils.SaveOperationContext, error) {
 	_, err := lambdaService.PutFunctionRecursionConfig(ctx, u.input)
-	return saveOpCtx, err
+	return saveOpCtx, functionUpdateError(err)
 }
 
 type functionRuntimeManagementConfigUpdate struct {
@@ -185,6 +243,7 @@ func (u *functionRuntimeManagementConfigUpdate) Prepare(
 		saveOpCtx.ProviderUpstreamID,
 		runtimeMgmtConfigData,
 		changes,
+		"spec.runtimeManagementConfig",
 	)
 	u.input = input
 	return hasUpdates, saveOpCtx, nil
@@ -196,5 +255,60 @@ func (u *functionRuntimeManagementConfigUpdate) Execute(
 	lambdaService Service,
 ) (pluginutils.SaveOperationContext, error) {
 	_, err := lambdaService.PutRuntimeManagementConfig(ctx, u.input)
-	return saveOpCtx, err
+	return saveOpCtx, functionUpdateError(err)
+}
+
+type functionSnapStartVersionPublish struct {
+	input *lambda.PublishVersionInput
+}
+
+func (u *functionSnapStartVersionPublish) Name() string {
+	return "publish SnapStart version"
+}
+
+func (u *functionSnapStartVersionPublish) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	_ *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	versionChanged, _ := saveOpCtx.Data["functionVersionChanged"].(bool)
+	_, alreadyPublished := saveOpCtx.Data["publishedFunctionVersion"].(*publishedFunctionVersion)
+	if !versionChanged || alreadyPublished || !isSnapStartEnabled(specData) {
+		return false, saveOpCtx, nil
+	}
+
+	u.input = &lambda.PublishVersionInput{
+		FunctionName: aws.String(saveOpCtx.ProviderUpstreamID),
+	}
+	return true, saveOpCtx, nil
+}
+
+func (u *functionSnapStartVersionPublish) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	// A version can only be published once the function has been created
+	// and all updates to the unpublished version have been applied.
+	err := waitForFunctionUpdateToComplete(
+		ctx,
+		lambdaService,
+		aws.ToString(u.input.FunctionName),
+		defaultFunctionUpdateWaitConfig,
+	)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	publishVersionOutput, err := lambdaService.PublishVersion(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, functionUpdateError(err)
+	}
+	recordPublishedFunctionVersion(
+		saveOpCtx,
+		publishVersionOutput.Version,
+		publishVersionOutput.FunctionArn,
+	)
+
+	return saveOpCtx, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
 	"github.com/newstack-cloud/celerity-provider-aws/utils"
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
@@ -22,6 +23,9 @@ type LambdaFunctionResourceUpdateSuite struct {
 }
 
 func (s *LambdaFunctionResourceUpdateSuite) Test_update_lambda_function() {
+	codePackage, err := packageFunctionCodeFromPath(testFunctionCodePath, "")
+	s.Require().NoError(err)
+
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -39,11 +43,42 @@ func (s *LambdaFunctionResourceUpdateSuite) Test_update_lambda_function() {
 		createFunctionConfigAndCodeUpdateTestCase(providerCtx, loader),
 		createMultipleConfigsUpdateTestCase(providerCtx, loader),
 		createUpdateFailureTestCase(providerCtx, loader),
+		createCodePathUnchangedTestCase(providerCtx, loader, codePackage),
+		createCodePathChangedTestCase(providerCtx, loader, codePackage),
+		createDeployedCodeChangedTestCase(providerCtx, loader, codePackage),
+		createCodeMatchesDeployedCodeTestCase(providerCtx, loader, codePackage),
+		createS3CodeMatchesDeployedCodeTestCase(providerCtx, loader, codePackage),
+		createImageTagMovedTestCase(providerCtx, loader),
+		createImageTagUnchangedTestCase(providerCtx, loader),
+		createSnapStartConfigUpdateTestCase(providerCtx, loader),
+		createSnapStartCodeUpdateTestCase(providerCtx, loader),
+		createSnapStartUnversionedUpdateTestCase(providerCtx, loader),
+		createImmutableFieldChangeTestCase(
+			"fail to update function name in place",
+			"functionName",
+			core.MappingNodeFromString("new-function-name"),
+			providerCtx,
+			loader,
+		),
+		createImmutableFieldChangeTestCase(
+			"fail to update package type in place",
+			"packageType",
+			core.MappingNodeFromString("Image"),
+			providerCtx,
+			loader,
+		),
 	}
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		FunctionResource,
+		functionResourceFactory(
+			createS3ServiceMock(
+				WithHeadObjectOutput(&s3sdk.HeadObjectOutput{
+					ChecksumSHA256: aws.String(codePackage.sha256),
+				}),
+			),
+			createTestImageECRServiceMock(testImageDigest),
+		),
 		&s.Suite,
 	)
 }
@@ -71,12 +106,13 @@ func createBasicFunctionUpdateTestCase(
 	// Create test data for function configuration updates
 	currentStateSpecData := &core.MappingNode{
 		Fields: map[string]*core.MappingNode{
-			"arn":         core.MappingNodeFromString(resourceARN),
-			"description": core.MappingNodeFromString("Original description"),
-			"memorySize":  core.MappingNodeFromInt(128),
-			"timeout":     core.MappingNodeFromInt(3),
-			"runtime":     core.MappingNodeFromString("nodejs18.x"),
-			"handler":     core.MappingNodeFromString("index.handler"),
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"description":  core.MappingNodeFromString("Original description"),
+			"memorySize":   core.MappingNodeFromInt(128),
+			"timeout":      core.MappingNodeFromInt(3),
+			"runtime":      core.MappingNodeFromString("nodejs18.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
 			"imageConfig": {
 				Fields: map[string]*core.MappingNode{
 					"command": core.MappingNodeFromStringSlice(
@@ -487,8 +523,7 @@ func createFunctionConfigAndCodeUpdateTestCase(
 			},
 			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
 				FunctionName: aws.String(resourceARN),
-				ZipFile:      []byte("new code"),
-				Publish:      true,
+				ZipFile:      inlineFunctionCodeZip("index.js", "new code"),
 			},
 			"GetFunction": &lambda.GetFunctionInput{
 				FunctionName: aws.String(resourceARN),
@@ -754,6 +789,904 @@ func createUpdateFailureTestCase(
 	}
 }
 
+func createImmutableFieldChangeTestCase(
+	name string,
+	field string,
+	newValue *core.MappingNode,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"memorySize":   core.MappingNodeFromInt(128),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"memorySize":   core.MappingNodeFromInt(256),
+		},
+	}
+	updatedSpecData.Fields[field] = newValue
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.memorySize",
+					},
+					{
+						FieldPath: fmt.Sprintf("spec.%s", field),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+			"UpdateFunctionCode",
+			"PutFunctionCodeSigningConfig",
+			"PutFunctionConcurrency",
+			"PutFunctionRecursionConfig",
+			"PutRuntimeManagementConfig",
+			"TagResource",
+			"UntagResource",
+		},
+	}
+}
+
+func createCodePathUnchangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	codePackage *functionCodePackage,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String(codePackage.sha256),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(128),
+			"runtime":    core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+			"codeSha256": core.MappingNodeFromString(codePackage.sha256),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(256),
+			"runtime":    core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "skip code update when packaged code from a local path is unchanged",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.memorySize",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(codePackage.sha256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(resourceARN),
+				MemorySize:   aws.Int32(256),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+		},
+	}
+}
+
+func createCodePathChangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	codePackage *functionCodePackage,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	previousCodeSha256 := functionCodeSHA256([]byte("previous package"))
+
+	service := createLambdaServiceMock(
+		// The mock service returns the same output for every call,
+		// so the deployed code hash is the hash of the previous package
+		// both before and after the code update.
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String(previousCodeSha256),
+			},
+		}),
+	)
+
+	specData := func(codeSha256 string) *core.MappingNode {
+		fields := map[string]*core.MappingNode{
+			"arn":     core.MappingNodeFromString(resourceARN),
+			"runtime": core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+		}
+		if codeSha256 != "" {
+			fields["codeSha256"] = core.MappingNodeFromString(codeSha256)
+		}
+		return &core.MappingNode{Fields: fields}
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update code when packaged code from a local path has changed",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		// The spec is unchanged as the source files at the same path
+		// have been modified since the last deployment.
+		Input: createFunctionCodePathUpdateInput(
+			specData(previousCodeSha256),
+			specData(""),
+			[]provider.FieldChange{},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(previousCodeSha256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				ZipFile:      codePackage.zipFile,
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func createDeployedCodeChangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	codePackage *functionCodePackage,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	modifiedCodeSha256 := functionCodeSHA256([]byte("modified package"))
+
+	service := createLambdaServiceMock(
+		// The deployed code has been changed outside of the provider
+		// so it no longer matches the hash recorded in the resource state.
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String(modifiedCodeSha256),
+			},
+		}),
+	)
+
+	specData := func(codeSha256 string) *core.MappingNode {
+		fields := map[string]*core.MappingNode{
+			"arn":     core.MappingNodeFromString(resourceARN),
+			"runtime": core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+		}
+		if codeSha256 != "" {
+			fields["codeSha256"] = core.MappingNodeFromString(codeSha256)
+		}
+		return &core.MappingNode{Fields: fields}
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update code when the deployed code has changed outside of the provider",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		// The hash in the current state matches the package built from the source files.
+		Input: createFunctionCodePathUpdateInput(
+			specData(codePackage.sha256),
+			specData(""),
+			[]provider.FieldChange{},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(modifiedCodeSha256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				ZipFile:      codePackage.zipFile,
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func createCodeMatchesDeployedCodeTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	codePackage *functionCodePackage,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String(codePackage.sha256),
+			},
+		}),
+	)
+
+	// The current state does not contain a code hash, this is the case
+	// for functions that were last deployed from a different code source
+	// or before the hash was recorded.
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":     core.MappingNodeFromString(resourceARN),
+			"runtime": core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("my-bucket"),
+					"s3Key":    core.MappingNodeFromString("function.zip"),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":     core.MappingNodeFromString(resourceARN),
+			"runtime": core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"path": core.MappingNodeFromString(testFunctionCodePath),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "skip code update when the package matches the deployed code",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.code.path",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(codePackage.sha256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"GetFunction": &lambda.GetFunctionInput{
+				FunctionName: aws.String(resourceARN),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func createS3CodeMatchesDeployedCodeTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	codePackage *functionCodePackage,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String(codePackage.sha256),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":     core.MappingNodeFromString(resourceARN),
+			"runtime": core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("my-bucket"),
+					"s3Key":    core.MappingNodeFromString("function.zip"),
+				},
+			},
+		},
+	}
+
+	// The package has been copied to a new key without any changes
+	// to its contents.
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":     core.MappingNodeFromString(resourceARN),
+			"runtime": core.MappingNodeFromString("nodejs22.x"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("my-bucket"),
+					"s3Key":    core.MappingNodeFromString("releases/function.zip"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "skip code update when the package in S3 matches the deployed code",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.code.s3Key",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(codePackage.sha256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"GetFunction": &lambda.GetFunctionInput{
+				FunctionName: aws.String(resourceARN),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func createImageTagMovedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	imageURI := "123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image:latest"
+	previousDigest := "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":         core.MappingNodeFromString(resourceARN),
+			"packageType": core.MappingNodeFromString("Image"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"imageUri": core.MappingNodeFromString(imageURI),
+				},
+			},
+			"imageDigest": core.MappingNodeFromString(previousDigest),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":         core.MappingNodeFromString(resourceARN),
+			"packageType": core.MappingNodeFromString("Image"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"imageUri": core.MappingNodeFromString(imageURI),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update code when image tag points to a new image",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		// The spec is unchanged, the tag has been moved to a new image.
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":         core.MappingNodeFromString(resourceARN),
+				"spec.imageDigest": core.MappingNodeFromString(testImageDigest),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				ImageUri: aws.String(
+					"123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image@" + testImageDigest,
+				),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func createImageTagUnchangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	imageURI := "123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image:latest"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":         core.MappingNodeFromString(resourceARN),
+			"packageType": core.MappingNodeFromString("Image"),
+			"memorySize":  core.MappingNodeFromInt(128),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"imageUri": core.MappingNodeFromString(imageURI),
+				},
+			},
+			"imageDigest": core.MappingNodeFromString(testImageDigest),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":         core.MappingNodeFromString(resourceARN),
+			"packageType": core.MappingNodeFromString("Image"),
+			"memorySize":  core.MappingNodeFromInt(256),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"imageUri": core.MappingNodeFromString(imageURI),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "skip code update when image tag points to the deployed image",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.memorySize",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":         core.MappingNodeFromString(resourceARN),
+				"spec.imageDigest": core.MappingNodeFromString(testImageDigest),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(resourceARN),
+				MemorySize:   aws.Int32(256),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+		},
+	}
+}
+
+func createSnapStartConfigUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				State:       types.StateActive,
+			},
+		}),
+		WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(resourceARN + ":3"),
+			Version:     aws.String("3"),
+		}),
+	)
+
+	currentStateSpecData := snapStartFunctionSpec(resourceARN, 128)
+	currentStateSpecData.Fields["publishedVersion"] = core.MappingNodeFromString("2")
+	currentStateSpecData.Fields["publishedVersionArn"] = core.MappingNodeFromString(resourceARN + ":2")
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "publish a version after a configuration update for a SnapStart function",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			snapStartFunctionSpec(resourceARN, 256),
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.memorySize",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":                 core.MappingNodeFromString(resourceARN),
+				"spec.publishedVersion":    core.MappingNodeFromString("3"),
+				"spec.publishedVersionArn": core.MappingNodeFromString(resourceARN + ":3"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(resourceARN),
+				MemorySize:   aws.Int32(256),
+			},
+			"PublishVersion": &lambda.PublishVersionInput{
+				FunctionName: aws.String(resourceARN),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+		},
+	}
+}
+
+func createSnapStartCodeUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				State:       types.StateActive,
+			},
+		}),
+		WithUpdateFunctionCodeOutput(&lambda.UpdateFunctionCodeOutput{
+			FunctionArn: aws.String(resourceARN + ":3"),
+			Version:     aws.String("3"),
+		}),
+	)
+
+	updatedSpecData := snapStartFunctionSpec(resourceARN, 128)
+	updatedSpecData.Fields["code"] = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"zipFile": core.MappingNodeFromString("new code"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "use the version published by a code update for a SnapStart function",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			snapStartFunctionSpec(resourceARN, 128),
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.code.zipFile",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":                 core.MappingNodeFromString(resourceARN),
+				"spec.publishedVersion":    core.MappingNodeFromString("3"),
+				"spec.publishedVersionArn": core.MappingNodeFromString(resourceARN + ":3"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				ZipFile:      inlineFunctionCodeZip("index.py", "new code"),
+				Publish:      true,
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+			"PublishVersion",
+		},
+	}
+}
+
+func createSnapStartUnversionedUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				State:       types.StateActive,
+			},
+		}),
+		WithPutFunctionConcurrencyOutput(&lambda.PutFunctionConcurrencyOutput{}),
+	)
+
+	currentStateSpecData := snapStartFunctionSpec(resourceARN, 128)
+	currentStateSpecData.Fields["publishedVersion"] = core.MappingNodeFromString("2")
+	currentStateSpecData.Fields["publishedVersionArn"] = core.MappingNodeFromString(resourceARN + ":2")
+
+	updatedSpecData := snapStartFunctionSpec(resourceARN, 128)
+	updatedSpecData.Fields["reservedConcurrentExecutions"] = core.MappingNodeFromInt(10)
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "keep the published version for a SnapStart function when the version is unchanged",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createFunctionCodePathUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.reservedConcurrentExecutions",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":                 core.MappingNodeFromString(resourceARN),
+				"spec.publishedVersion":    core.MappingNodeFromString("2"),
+				"spec.publishedVersionArn": core.MappingNodeFromString(resourceARN + ":2"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutFunctionConcurrency": &lambda.PutFunctionConcurrencyInput{
+				FunctionName:                 aws.String(resourceARN),
+				ReservedConcurrentExecutions: aws.Int32(10),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+			"UpdateFunctionCode",
+			"PublishVersion",
+		},
+	}
+}
+
+func snapStartFunctionSpec(resourceARN string, memorySize int) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("python3.13"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"memorySize":   core.MappingNodeFromInt(memorySize),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("test-function.zip"),
+				},
+			},
+			"snapStart": {
+				Fields: map[string]*core.MappingNode{
+					"applyOn": core.MappingNodeFromString("PublishedVersions"),
+				},
+			},
+		},
+	}
+}
+
+func createFunctionCodePathUpdateInput(
+	currentStateSpecData *core.MappingNode,
+	updatedSpecData *core.MappingNode,
+	modifiedFields []provider.FieldChange,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-function-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-function-id",
+				ResourceName: "TestFunction",
+				InstanceID:   "test-instance-id",
+				CurrentResourceState: &state.ResourceState{
+					ResourceID: "test-function-id",
+					Name:       "TestFunction",
+					InstanceID: "test-instance-id",
+					SpecData:   currentStateSpecData,
+				},
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/function",
+					},
+					Spec: updatedSpecData,
+				},
+			},
+			ModifiedFields: modifiedFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
 func TestLambdaFunctionResourceUpdate(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceUpdateSuite))
 }
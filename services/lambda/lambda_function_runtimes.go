@@ -0,0 +1,371 @@
+package lambda
+
+import (
+	"time"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+)
+
+// The period before a runtime is deprecated in which a warning
+// is reported for functions that use the runtime.
+// Lambda notifies accounts with functions that use a runtime
+// at least 180 days before the runtime is deprecated.
+const runtimeDeprecationWarningPeriod = 180 * 24 * time.Hour
+
+// lambdaRuntime holds the lifecycle information for a Lambda runtime.
+// Dates that have not been scheduled are left as the zero value.
+// See: https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html
+type lambdaRuntime struct {
+	identifier string
+	// The language used for inline code written for the runtime,
+	// custom runtimes use the "provided" language.
+	language string
+	// The date from which the runtime no longer receives
+	// security patches or updates.
+	deprecationDate time.Time
+	// The date from which new functions can not be created
+	// with the runtime.
+	blockCreateDate time.Time
+	// The date from which existing functions using the runtime
+	// can no longer be updated.
+	blockUpdateDate   time.Time
+	supportsSnapStart bool
+}
+
+func (r *lambdaRuntime) isDeprecated(now time.Time) bool {
+	return isScheduledBefore(r.deprecationDate, now)
+}
+
+func (r *lambdaRuntime) isNearingDeprecation(now time.Time) bool {
+	return isScheduledBefore(r.deprecationDate, now.Add(runtimeDeprecationWarningPeriod))
+}
+
+func (r *lambdaRuntime) isCreateBlocked(now time.Time) bool {
+	return isScheduledBefore(r.blockCreateDate, now)
+}
+
+func (r *lambdaRuntime) isUpdateBlocked(now time.Time) bool {
+	return isScheduledBefore(r.blockUpdateDate, now)
+}
+
+func isScheduledBefore(date time.Time, now time.Time) bool {
+	return !date.IsZero() && !now.Before(date)
+}
+
+// lambdaRuntimes is the catalogue of runtimes that can be used
+// for a function, this must be updated as Lambda adds new runtimes
+// and schedules deprecations.
+var lambdaRuntimes = []*lambdaRuntime{
+	{
+		identifier:      "nodejs",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2016-10-31"),
+		blockCreateDate: runtimeDate("2016-10-31"),
+		blockUpdateDate: runtimeDate("2016-10-31"),
+	},
+	{
+		identifier:      "nodejs4.3",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2020-03-05"),
+		blockCreateDate: runtimeDate("2020-03-05"),
+		blockUpdateDate: runtimeDate("2020-03-05"),
+	},
+	{
+		identifier:      "nodejs4.3-edge",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2020-03-05"),
+		blockCreateDate: runtimeDate("2020-03-05"),
+		blockUpdateDate: runtimeDate("2020-04-30"),
+	},
+	{
+		identifier:      "nodejs6.10",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2019-08-12"),
+		blockCreateDate: runtimeDate("2019-08-12"),
+		blockUpdateDate: runtimeDate("2019-08-12"),
+	},
+	{
+		identifier:      "nodejs8.10",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2020-03-06"),
+		blockCreateDate: runtimeDate("2020-03-06"),
+		blockUpdateDate: runtimeDate("2020-03-06"),
+	},
+	{
+		identifier:      "nodejs10.x",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2021-07-30"),
+		blockCreateDate: runtimeDate("2021-07-30"),
+		blockUpdateDate: runtimeDate("2022-02-14"),
+	},
+	{
+		identifier:      "nodejs12.x",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2023-03-31"),
+		blockCreateDate: runtimeDate("2023-03-31"),
+		blockUpdateDate: runtimeDate("2023-04-30"),
+	},
+	{
+		identifier:      "nodejs14.x",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2023-12-04"),
+		blockCreateDate: runtimeDate("2024-01-09"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "nodejs16.x",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2024-06-12"),
+		blockCreateDate: runtimeDate("2026-02-28"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "nodejs18.x",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2025-09-01"),
+		blockCreateDate: runtimeDate("2026-02-03"),
+		blockUpdateDate: runtimeDate("2026-03-09"),
+	},
+	{
+		identifier:      "nodejs20.x",
+		language:        "nodejs",
+		deprecationDate: runtimeDate("2026-04-30"),
+		blockCreateDate: runtimeDate("2026-08-31"),
+		blockUpdateDate: runtimeDate("2026-09-30"),
+	},
+	{
+		identifier: "nodejs22.x",
+		language:   "nodejs",
+	},
+	{
+		identifier:      "java8",
+		language:        "java",
+		deprecationDate: runtimeDate("2024-01-08"),
+		blockCreateDate: runtimeDate("2024-02-08"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "java8.al2",
+		language:        "java",
+		deprecationDate: runtimeDate("2026-06-30"),
+		blockCreateDate: runtimeDate("2026-08-31"),
+		blockUpdateDate: runtimeDate("2026-09-30"),
+	},
+	{
+		identifier:        "java11",
+		language:          "java",
+		supportsSnapStart: true,
+	},
+	{
+		identifier:        "java17",
+		language:          "java",
+		supportsSnapStart: true,
+	},
+	{
+		identifier:        "java21",
+		language:          "java",
+		supportsSnapStart: true,
+	},
+	{
+		identifier:      "python2.7",
+		language:        "python",
+		deprecationDate: runtimeDate("2021-07-15"),
+		blockCreateDate: runtimeDate("2021-07-15"),
+		blockUpdateDate: runtimeDate("2022-05-30"),
+	},
+	{
+		identifier:      "python3.6",
+		language:        "python",
+		deprecationDate: runtimeDate("2022-07-18"),
+		blockCreateDate: runtimeDate("2022-07-18"),
+		blockUpdateDate: runtimeDate("2022-08-29"),
+	},
+	{
+		identifier:      "python3.7",
+		language:        "python",
+		deprecationDate: runtimeDate("2023-12-04"),
+		blockCreateDate: runtimeDate("2024-01-09"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "python3.8",
+		language:        "python",
+		deprecationDate: runtimeDate("2024-10-14"),
+		blockCreateDate: runtimeDate("2026-02-28"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "python3.9",
+		language:        "python",
+		deprecationDate: runtimeDate("2025-12-15"),
+		blockCreateDate: runtimeDate("2026-06-01"),
+		blockUpdateDate: runtimeDate("2026-07-01"),
+	},
+	{
+		identifier:      "python3.10",
+		language:        "python",
+		deprecationDate: runtimeDate("2026-06-30"),
+		blockCreateDate: runtimeDate("2026-08-31"),
+		blockUpdateDate: runtimeDate("2026-09-30"),
+	},
+	{
+		identifier: "python3.11",
+		language:   "python",
+	},
+	{
+		identifier:        "python3.12",
+		language:          "python",
+		supportsSnapStart: true,
+	},
+	{
+		identifier:        "python3.13",
+		language:          "python",
+		supportsSnapStart: true,
+	},
+	{
+		identifier:      "dotnetcore1.0",
+		language:        "dotnet",
+		deprecationDate: runtimeDate("2019-07-30"),
+		blockCreateDate: runtimeDate("2019-07-30"),
+		blockUpdateDate: runtimeDate("2019-07-30"),
+	},
+	{
+		identifier:      "dotnetcore2.0",
+		language:        "dotnet",
+		deprecationDate: runtimeDate("2019-05-30"),
+		blockCreateDate: runtimeDate("2019-05-30"),
+		blockUpdateDate: runtimeDate("2019-05-30"),
+	},
+	{
+		identifier:      "dotnetcore2.1",
+		language:        "dotnet",
+		deprecationDate: runtimeDate("2022-01-05"),
+		blockCreateDate: runtimeDate("2022-01-05"),
+		blockUpdateDate: runtimeDate("2022-04-13"),
+	},
+	{
+		identifier:      "dotnetcore3.1",
+		language:        "dotnet",
+		deprecationDate: runtimeDate("2023-04-03"),
+		blockCreateDate: runtimeDate("2023-04-03"),
+		blockUpdateDate: runtimeDate("2023-05-03"),
+	},
+	{
+		identifier:      "dotnet6",
+		language:        "dotnet",
+		deprecationDate: runtimeDate("2024-12-20"),
+		blockCreateDate: runtimeDate("2026-02-28"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "dotnet7",
+		language:        "dotnet",
+		deprecationDate: runtimeDate("2024-05-14"),
+		blockCreateDate: runtimeDate("2026-02-28"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:        "dotnet8",
+		language:          "dotnet",
+		deprecationDate:   runtimeDate("2026-11-10"),
+		supportsSnapStart: true,
+	},
+	{
+		identifier:      "go1.x",
+		language:        "go",
+		deprecationDate: runtimeDate("2024-01-08"),
+		blockCreateDate: runtimeDate("2024-02-08"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "ruby2.5",
+		language:        "ruby",
+		deprecationDate: runtimeDate("2021-07-30"),
+		blockCreateDate: runtimeDate("2021-07-30"),
+		blockUpdateDate: runtimeDate("2022-03-31"),
+	},
+	{
+		identifier:      "ruby2.7",
+		language:        "ruby",
+		deprecationDate: runtimeDate("2023-12-07"),
+		blockCreateDate: runtimeDate("2024-01-09"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "ruby3.2",
+		language:        "ruby",
+		deprecationDate: runtimeDate("2026-03-31"),
+		blockCreateDate: runtimeDate("2026-08-31"),
+		blockUpdateDate: runtimeDate("2026-09-30"),
+	},
+	{
+		identifier: "ruby3.3",
+		language:   "ruby",
+	},
+	{
+		identifier: "ruby3.4",
+		language:   "ruby",
+	},
+	{
+		identifier:      "provided",
+		language:        "provided",
+		deprecationDate: runtimeDate("2024-01-08"),
+		blockCreateDate: runtimeDate("2024-02-08"),
+		blockUpdateDate: runtimeDate("2026-03-31"),
+	},
+	{
+		identifier:      "provided.al2",
+		language:        "provided",
+		deprecationDate: runtimeDate("2026-06-30"),
+		blockCreateDate: runtimeDate("2026-08-31"),
+		blockUpdateDate: runtimeDate("2026-09-30"),
+	},
+	{
+		identifier: "provided.al2023",
+		language:   "provided",
+	},
+}
+
+func findLambdaRuntime(identifier string) (*lambdaRuntime, bool) {
+	for _, runtime := range lambdaRuntimes {
+		if runtime.identifier == identifier {
+			return runtime, true
+		}
+	}
+
+	return nil, false
+}
+
+// The runtimes that can be used for functions and layers,
+// this includes deprecated runtimes so that existing functions
+// and layers can still be managed by the provider.
+func lambdaRuntimeAllowedValues() []*core.MappingNode {
+	allowedValues := make([]*core.MappingNode, 0, len(lambdaRuntimes))
+	for _, runtime := range lambdaRuntimes {
+		allowedValues = append(allowedValues, core.MappingNodeFromString(runtime.identifier))
+	}
+
+	return allowedValues
+}
+
+// snapStartSupportedRuntimes returns the identifiers of the runtimes
+// that support Lambda SnapStart.
+func snapStartSupportedRuntimes() []string {
+	identifiers := []string{}
+	for _, runtime := range lambdaRuntimes {
+		if runtime.supportsSnapStart {
+			identifiers = append(identifiers, runtime.identifier)
+		}
+	}
+
+	return identifiers
+}
+
+func runtimeDate(date string) time.Time {
+	parsed, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
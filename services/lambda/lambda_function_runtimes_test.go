@@ -0,0 +1,67 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionRuntimesSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionRuntimesSuite) Test_runtime_catalogue_has_consistent_lifecycle_dates() {
+	seen := map[string]bool{}
+	for _, runtime := range lambdaRuntimes {
+		s.Assert().False(seen[runtime.identifier], "duplicate runtime %s", runtime.identifier)
+		seen[runtime.identifier] = true
+
+		s.Assert().NotEmpty(runtime.language, "runtime %s is missing a language", runtime.identifier)
+
+		if !runtime.blockCreateDate.IsZero() || !runtime.blockUpdateDate.IsZero() {
+			s.Assert().False(
+				runtime.deprecationDate.IsZero(),
+				"runtime %s is blocked without a deprecation date",
+				runtime.identifier,
+			)
+		}
+
+		if !runtime.blockCreateDate.IsZero() {
+			s.Assert().False(
+				runtime.blockUpdateDate.IsZero(),
+				"runtime %s blocks creates without a date to block updates",
+				runtime.identifier,
+			)
+			s.Assert().False(
+				runtime.blockCreateDate.Before(runtime.deprecationDate),
+				"runtime %s blocks creates before it is deprecated",
+				runtime.identifier,
+			)
+			s.Assert().False(
+				runtime.blockUpdateDate.Before(runtime.blockCreateDate),
+				"runtime %s blocks updates before it blocks creates",
+				runtime.identifier,
+			)
+		}
+	}
+}
+
+func (s *LambdaFunctionRuntimesSuite) Test_derives_inline_code_language_from_catalogue() {
+	s.Assert().Equal("nodejs", getLanguageFromRuntime("nodejs22.x"))
+	s.Assert().Equal("python", getLanguageFromRuntime("python3.13"))
+	s.Assert().Equal("ruby", getLanguageFromRuntime("ruby3.4"))
+	s.Assert().Equal("provided", getLanguageFromRuntime("provided.al2023"))
+	s.Assert().Equal("java", getLanguageFromRuntime("java21"))
+	s.Assert().Equal("", getLanguageFromRuntime("unknown1.0"))
+}
+
+func (s *LambdaFunctionRuntimesSuite) Test_lists_snap_start_runtimes_from_catalogue() {
+	s.Assert().Equal(
+		[]string{"java11", "java17", "java21", "python3.12", "python3.13", "dotnet8"},
+		snapStartSupportedRuntimes(),
+	)
+}
+
+func TestLambdaFunctionRuntimesSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionRuntimesSuite))
+}
@@ -0,0 +1,70 @@
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+// The version of a function that represents the unpublished
+// code and configuration of the function.
+const unpublishedFunctionVersion = "$LATEST"
+
+// isSnapStartEnabled determines whether SnapStart is enabled for the
+// provided function spec.
+// SnapStart only takes effect for published versions of a function,
+// so a version must be published for changes to the function code
+// or configuration to be optimised.
+func isSnapStartEnabled(specData *core.MappingNode) bool {
+	applyOn, hasApplyOn := pluginutils.GetValueByPath("$.snapStart.applyOn", specData)
+	return hasApplyOn &&
+		core.StringValue(applyOn) == string(types.SnapStartApplyOnPublishedVersions)
+}
+
+// markFunctionVersionChanged records that the code or configuration
+// of the unpublished version of a function has been changed,
+// a new version is published for SnapStart-enabled functions
+// after all changes have been applied.
+func markFunctionVersionChanged(saveOpCtx pluginutils.SaveOperationContext) {
+	if saveOpCtx.Data != nil {
+		saveOpCtx.Data["functionVersionChanged"] = true
+	}
+}
+
+// publishedFunctionVersion holds the version of a function that was
+// published while applying changes to the function.
+type publishedFunctionVersion struct {
+	version string
+	arn     string
+}
+
+// recordPublishedFunctionVersion stores the version published by an operation
+// so the version is not published again and can be returned as a computed field.
+// Operations that do not publish a version return the unpublished "$LATEST"
+// version of the function, which is ignored.
+func recordPublishedFunctionVersion(
+	saveOpCtx pluginutils.SaveOperationContext,
+	version *string,
+	arn *string,
+) {
+	if saveOpCtx.Data == nil ||
+		aws.ToString(version) == "" ||
+		aws.ToString(version) == unpublishedFunctionVersion {
+		return
+	}
+
+	saveOpCtx.Data["publishedFunctionVersion"] = &publishedFunctionVersion{
+		version: aws.ToString(version),
+		arn:     aws.ToString(arn),
+	}
+}
+
+func publishedVersionComputedFields(
+	publishedVersion *publishedFunctionVersion,
+) map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"spec.publishedVersion":    core.MappingNodeFromString(publishedVersion.version),
+		"spec.publishedVersionArn": core.MappingNodeFromString(publishedVersion.arn),
+	}
+}
@@ -1,11 +1,19 @@
 package lambda
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
 
 	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
 	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/source"
 	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
 )
 
@@ -23,15 +31,375 @@ func validateZipFileRuntime(
 		return []*core.Diagnostic{}
 	}
 
-	runtimeValue := core.StringValue(runtime)
-	if !strings.HasPrefix(runtimeValue, "nodejs") &&
-		!strings.HasPrefix(runtimeValue, "python") {
+	entrypoint, err := inlineCodeEntrypointForRuntime(core.StringValue(runtime))
+	if err != nil {
 		return []*core.Diagnostic{
 			{
 				Level: core.DiagnosticLevelError,
 				Message: fmt.Sprintf(
 					"The %s field with inline code is only "+
-						"supported for Node.js and Python runtimes.",
+						"supported for Node.js, Python, Ruby and custom (provided.*) runtimes.",
+					path,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+			},
+		}
+	}
+
+	handler, hasHandler := pluginutils.GetValueByPath("$.handler", resource.Spec)
+	if !hasHandler || handler.StringWithSubstitutions != nil {
+		return []*core.Diagnostic{}
+	}
+
+	err = entrypoint.validateHandler(core.StringValue(handler))
+	if err != nil {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field with inline code is written to the %s file, "+
+						"the handler must be of the form \"%s{handlerName}\".",
+					path,
+					entrypoint.fileName,
+					entrypoint.handlerPrefix,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(handler.SourceMeta, nil),
+			},
+		}
+	}
+
+	return []*core.Diagnostic{}
+}
+
+func validateInlineCodeFilePaths(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	if value == nil {
+		return []*core.Diagnostic{}
+	}
+
+	filePaths := make([]string, 0, len(value.Fields))
+	for filePath := range value.Fields {
+		filePaths = append(filePaths, filePath)
+	}
+	// Sort the file paths so diagnostics are reported in a consistent order.
+	slices.Sort(filePaths)
+
+	entrypointFileName := inlineCodeEntrypointFileName(resource)
+
+	diagnostics := []*core.Diagnostic{}
+	for _, filePath := range filePaths {
+		cleanFilePath, err := utils.CleanArchivePath(filePath)
+		if err != nil {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field contains an invalid file path: %s",
+					path,
+					err.Error(),
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(value.Fields[filePath].SourceMeta, nil),
+			})
+			continue
+		}
+
+		if entrypointFileName != "" && cleanFilePath == entrypointFileName {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field contains the file path %q which clashes with the %s file "+
+						"generated for the inline code in the code.zipFile field.",
+					path,
+					filePath,
+					entrypointFileName,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(value.Fields[filePath].SourceMeta, nil),
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+// inlineCodeEntrypointFileName returns the name of the file that the
+// code.zipFile field is written to for the resource.
+// This returns an empty string when code.zipFile is not set or the runtime
+// is not yet resolved or does not support inline code.
+func inlineCodeEntrypointFileName(resource *schema.Resource) string {
+	_, hasZipFile := pluginutils.GetValueByPath("$.code.zipFile", resource.Spec)
+	runtime, hasRuntime := pluginutils.GetValueByPath("$.runtime", resource.Spec)
+	if !hasZipFile || !hasRuntime || runtime.StringWithSubstitutions != nil {
+		return ""
+	}
+
+	entrypoint, err := inlineCodeEntrypointForRuntime(core.StringValue(runtime))
+	if err != nil {
+		return ""
+	}
+
+	return entrypoint.fileName
+}
+
+func validateRuntime(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	diagnostics := validateZipPackageTypeField(path, value, resource)
+	// Validation does not have access to the state of the function,
+	// new functions are checked against the runtime lifecycle again
+	// when they are created.
+	return append(diagnostics, validateRuntimeLifecycle(path, value, time.Now(), false)...)
+}
+
+// checkNewFunctionRuntime ensures that a new function is not created
+// with a runtime that Lambda no longer allows new functions to be created with.
+func checkNewFunctionRuntime(specData *core.MappingNode, now time.Time) error {
+	runtime, hasRuntime := pluginutils.GetValueByPath("$.runtime", specData)
+	if !hasRuntime {
+		return nil
+	}
+
+	failureReasons := []string{}
+	for _, diagnostic := range validateRuntimeLifecycle("runtime", runtime, now, true) {
+		if diagnostic.Level == core.DiagnosticLevelError {
+			failureReasons = append(failureReasons, diagnostic.Message)
+		}
+	}
+
+	if len(failureReasons) > 0 {
+		return &provider.ResourceDeployError{
+			FailureReasons: failureReasons,
+		}
+	}
+
+	return nil
+}
+
+// validateRuntimeLifecycle reports runtimes that are deprecated or
+// will soon be deprecated as warnings and runtimes that Lambda will
+// reject for all operations as errors.
+// Runtimes that can no longer be used to create new functions are reported
+// as errors for new functions and as warnings for existing functions,
+// as existing functions using the runtime can still be updated.
+func validateRuntimeLifecycle(
+	path string,
+	value *core.MappingNode,
+	now time.Time,
+	isNewFunction bool,
+) []*core.Diagnostic {
+	if value == nil || value.StringWithSubstitutions != nil {
+		return []*core.Diagnostic{}
+	}
+
+	runtime, isKnownRuntime := findLambdaRuntime(core.StringValue(value))
+	if !isKnownRuntime {
+		// Unknown runtimes are reported by the allowed values
+		// validation for the field.
+		return []*core.Diagnostic{}
+	}
+
+	diagnosticRange := core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil)
+
+	if runtime.isUpdateBlocked(now) {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field is set to the %s runtime which was deprecated on %s, "+
+						"Lambda no longer allows functions to be created or updated with this runtime.",
+					path,
+					runtime.identifier,
+					runtime.deprecationDate.Format(time.DateOnly),
+				),
+				Range: diagnosticRange,
+			},
+		}
+	}
+
+	if runtime.isCreateBlocked(now) && isNewFunction {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field is set to the %s runtime which was deprecated on %s, "+
+						"Lambda no longer allows new functions to be created with this runtime.",
+					path,
+					runtime.identifier,
+					runtime.deprecationDate.Format(time.DateOnly),
+				),
+				Range: diagnosticRange,
+			},
+		}
+	}
+
+	if runtime.isCreateBlocked(now) {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelWarning,
+				Message: fmt.Sprintf(
+					"The %s field is set to the %s runtime which was deprecated on %s, "+
+						"Lambda no longer allows new functions to be created with this runtime "+
+						"and existing functions can not be updated from %s.",
+					path,
+					runtime.identifier,
+					runtime.deprecationDate.Format(time.DateOnly),
+					runtime.blockUpdateDate.Format(time.DateOnly),
+				),
+				Range: diagnosticRange,
+			},
+		}
+	}
+
+	if runtime.isDeprecated(now) {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelWarning,
+				Message: fmt.Sprintf(
+					"The %s field is set to the %s runtime which was deprecated on %s "+
+						"and no longer receives security patches or updates.",
+					path,
+					runtime.identifier,
+					runtime.deprecationDate.Format(time.DateOnly),
+				),
+				Range: diagnosticRange,
+			},
+		}
+	}
+
+	if runtime.isNearingDeprecation(now) {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelWarning,
+				Message: fmt.Sprintf(
+					"The %s field is set to the %s runtime which will be deprecated on %s, "+
+						"consider upgrading to a newer runtime.",
+					path,
+					runtime.identifier,
+					runtime.deprecationDate.Format(time.DateOnly),
+				),
+				Range: diagnosticRange,
+			},
+		}
+	}
+
+	return []*core.Diagnostic{}
+}
+
+// validateZipPackageTypeField validates fields that can only be set
+// for functions deployed as a .zip file archive, for container images
+// the runtime and entry point are defined by the image.
+func validateZipPackageTypeField(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	packageType, isKnown := functionPackageTypeFromResource(resource)
+	if !isKnown || packageType != "Image" {
+		return []*core.Diagnostic{}
+	}
+
+	return []*core.Diagnostic{
+		{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s field can not be set for a function with the Image package type, "+
+					"the runtime and handler are defined by the container image.",
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+		},
+	}
+}
+
+// validateImagePackageTypeField validates fields that can only be set
+// for functions deployed as a container image.
+func validateImagePackageTypeField(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	packageType, isKnown := functionPackageTypeFromResource(resource)
+	if !isKnown || packageType == "Image" {
+		return []*core.Diagnostic{}
+	}
+
+	return []*core.Diagnostic{
+		{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s field can only be set for a function with the Image package type.",
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+		},
+	}
+}
+
+// validateFunctionCodePackageType validates that the fields required
+// to run a function deployed as a .zip file archive are set.
+// This is attached to the code field as it is always present in a valid
+// function spec, unlike the runtime and handler fields.
+func validateFunctionCodePackageType(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	packageType, isKnown := functionPackageTypeFromResource(resource)
+	if !isKnown || packageType != "Zip" {
+		return []*core.Diagnostic{}
+	}
+
+	// Report missing fields against the packageType field when it is set
+	// explicitly, otherwise the code field is used as the closest location.
+	rangeSource := value
+	if packageTypeNode, hasPackageType := pluginutils.GetValueByPath(
+		"$.packageType",
+		resource.Spec,
+	); hasPackageType {
+		rangeSource = packageTypeNode
+	}
+
+	diagnostics := []*core.Diagnostic{}
+	for _, field := range []string{"runtime", "handler"} {
+		if _, hasField := pluginutils.GetValueByPath("$."+field, resource.Spec); !hasField {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field must be set for a function with the Zip package type.",
+					field,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(rangeSource.SourceMeta, nil),
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+func validateSnapStartRuntime(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	applyOn, hasApplyOn := pluginutils.GetValueByPath("$.applyOn", value)
+	if !hasApplyOn || applyOn.StringWithSubstitutions != nil ||
+		core.StringValue(applyOn) != "PublishedVersions" {
+		// SnapStart is only enabled when applyOn is set to PublishedVersions,
+		// turning SnapStart off is allowed for all functions.
+		return []*core.Diagnostic{}
+	}
+
+	packageType, isKnown := functionPackageTypeFromResource(resource)
+	if isKnown && packageType == "Image" {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field can not be enabled for a function with the Image package type, "+
+						"SnapStart is not supported for container images.",
 					path,
 				),
 				Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
@@ -39,5 +407,239 @@ func validateZipFileRuntime(
 		}
 	}
 
+	runtime, hasRuntime := pluginutils.GetValueByPath("$.runtime", resource.Spec)
+	if !hasRuntime || runtime.StringWithSubstitutions != nil {
+		return []*core.Diagnostic{}
+	}
+
+	if !slices.Contains(snapStartSupportedRuntimes(), core.StringValue(runtime)) {
+		return []*core.Diagnostic{
+			{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field can not be enabled for the %s runtime, "+
+						"SnapStart is only supported for the following runtimes: %s.",
+					path,
+					core.StringValue(runtime),
+					strings.Join(snapStartSupportedRuntimes(), ", "),
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+			},
+		}
+	}
+
 	return []*core.Diagnostic{}
 }
+
+// functionPackageTypeFromResource returns the package type of the function,
+// falling back to the default Zip package type when the field is not set.
+// This returns false if the package type is not yet resolved.
+func functionPackageTypeFromResource(resource *schema.Resource) (string, bool) {
+	packageType, hasPackageType := pluginutils.GetValueByPath("$.packageType", resource.Spec)
+	if !hasPackageType {
+		return "Zip", true
+	}
+
+	if packageType.StringWithSubstitutions != nil {
+		return "", false
+	}
+
+	return core.StringValue(packageType), true
+}
+
+// The maximum total size of a function's environment variables,
+// Lambda measures this as the size of the variables serialised as JSON.
+const maxEnvironmentVariablesSize = 4 * 1024
+
+var environmentVariableKeyPattern = regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9_])+$`)
+
+// Environment variables that are set by the Lambda runtime
+// and can not be set in a function's configuration.
+// See: https://docs.aws.amazon.com/lambda/latest/dg/configuration-envvars.html#configuration-envvars-runtime
+var reservedEnvironmentVariables = []string{
+	"_HANDLER",
+	"_X_AMZN_TRACE_ID",
+	"AWS_ACCESS_KEY",
+	"AWS_ACCESS_KEY_ID",
+	"AWS_DEFAULT_REGION",
+	"AWS_EXECUTION_ENV",
+	"AWS_LAMBDA_FUNCTION_MEMORY_SIZE",
+	"AWS_LAMBDA_FUNCTION_NAME",
+	"AWS_LAMBDA_FUNCTION_VERSION",
+	"AWS_LAMBDA_INITIALIZATION_TYPE",
+	"AWS_LAMBDA_LOG_GROUP_NAME",
+	"AWS_LAMBDA_LOG_STREAM_NAME",
+	"AWS_LAMBDA_RUNTIME_API",
+	"AWS_REGION",
+	"AWS_SECRET_ACCESS_KEY",
+	"AWS_SESSION_TOKEN",
+	"LAMBDA_RUNTIME_DIR",
+	"LAMBDA_TASK_ROOT",
+}
+
+// Parts of environment variable keys that indicate that
+// the value is likely to be a secret.
+var secretEnvironmentVariableKeyParts = []string{
+	"SECRET",
+	"PASSWORD",
+	"PASSWD",
+	"TOKEN",
+	"API_KEY",
+	"APIKEY",
+	"PRIVATE_KEY",
+	"CREDENTIAL",
+}
+
+// Patterns for well-known secret formats that are likely
+// to be a secret regardless of the environment variable key.
+var secretEnvironmentVariableValuePatterns = []*regexp.Regexp{
+	// AWS access key IDs.
+	regexp.MustCompile(`^(AKIA|ASIA)[0-9A-Z]{16}$`),
+	// PEM encoded private keys.
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	// GitHub tokens.
+	regexp.MustCompile(`^gh[pousr]_[A-Za-z0-9]{36,}$`),
+	// Slack tokens.
+	regexp.MustCompile(`^xox[abposr]-[A-Za-z0-9-]+$`),
+}
+
+func validateEnvironmentVariables(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	if value == nil || value.Fields == nil {
+		return []*core.Diagnostic{}
+	}
+
+	keys := make([]string, 0, len(value.Fields))
+	for key := range value.Fields {
+		keys = append(keys, key)
+	}
+	// Sort the keys so diagnostics are reported in a consistent order.
+	slices.Sort(keys)
+
+	_, hasKMSKeyArn := pluginutils.GetValueByPath("$.kmsKeyArn", resource.Spec)
+
+	diagnostics := []*core.Diagnostic{}
+	for _, key := range keys {
+		keyRange := core.DiagnosticRangeFromSourceMeta(
+			environmentVariableKeySourceMeta(value, key),
+			nil,
+		)
+		if slices.Contains(reservedEnvironmentVariables, key) {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field contains the reserved environment variable %q, "+
+						"this is set by the Lambda runtime and can not be overridden.",
+					path,
+					key,
+				),
+				Range: keyRange,
+			})
+		} else if !environmentVariableKeyPattern.MatchString(key) {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field contains the invalid environment variable key %q, "+
+						"keys must start with a letter followed by at least one letter, number or underscore.",
+					path,
+					key,
+				),
+				Range: keyRange,
+			})
+		}
+
+		if !hasKMSKeyArn && isLikelyPlaintextSecret(key, value.Fields[key]) {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelWarning,
+				Message: fmt.Sprintf(
+					"The %s field contains the environment variable %q which looks like "+
+						"a plaintext secret and kmsKeyArn is not set, consider storing the secret "+
+						"in AWS Secrets Manager or setting kmsKeyArn to encrypt environment variables "+
+						"with a customer managed key.",
+					path,
+					key,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(value.Fields[key].SourceMeta, nil),
+			})
+		}
+	}
+
+	size, isKnownSize := environmentVariablesSize(value)
+	if isKnownSize && size > maxEnvironmentVariablesSize {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s field has a total size of %d bytes, "+
+					"the total size of all environment variables must not exceed %d bytes.",
+				path,
+				size,
+				maxEnvironmentVariablesSize,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+		})
+	}
+
+	return diagnostics
+}
+
+// environmentVariablesSize computes the size of the environment variables
+// serialised as JSON.
+// This returns false if any of the values are not yet resolved.
+func environmentVariablesSize(variables *core.MappingNode) (int, bool) {
+	resolved := make(map[string]string, len(variables.Fields))
+	for key, value := range variables.Fields {
+		if value == nil || value.StringWithSubstitutions != nil {
+			return 0, false
+		}
+		resolved[key] = core.StringValue(value)
+	}
+
+	serialised, err := json.Marshal(resolved)
+	if err != nil {
+		return 0, false
+	}
+
+	return len(serialised), true
+}
+
+func isLikelyPlaintextSecret(key string, value *core.MappingNode) bool {
+	if value == nil || value.StringWithSubstitutions != nil {
+		// Values that are resolved from substitutions are usually
+		// sourced from other resources or secret stores.
+		return false
+	}
+
+	stringValue := core.StringValue(value)
+	if stringValue == "" {
+		return false
+	}
+
+	for _, pattern := range secretEnvironmentVariableValuePatterns {
+		if pattern.MatchString(stringValue) {
+			return true
+		}
+	}
+
+	upperKey := strings.ToUpper(key)
+	return slices.ContainsFunc(
+		secretEnvironmentVariableKeyParts,
+		func(part string) bool {
+			return strings.Contains(upperKey, part)
+		},
+	)
+}
+
+func environmentVariableKeySourceMeta(variables *core.MappingNode, key string) *source.Meta {
+	if keySourceMeta, hasKeySourceMeta := variables.FieldsSourceMeta[key]; hasKeySourceMeta {
+		return keySourceMeta
+	}
+
+	if value := variables.Fields[key]; value != nil {
+		return value.SourceMeta
+	}
+
+	return variables.SourceMeta
+}
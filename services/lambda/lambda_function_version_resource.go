@@ -0,0 +1,65 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// FunctionVersionResource returns a resource implementation for an AWS Lambda Function Version.
+func FunctionVersionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_function_version_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_function_version_jsonc.md")
+
+	lambdaFunctionVersionActions := &lambdaFunctionVersionResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/functionVersion",
+		Label:            "AWS Lambda Function Version",
+		PlainTextSummary: "A resource for managing an immutable published version of an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define a [Lambda function version](https://docs.aws.amazon.com/lambda/latest/dg/configuration-versions.html) " +
+			"that is published from the current code and configuration of a function deployed to AWS.",
+		Schema:  lambdaFunctionVersionResourceSchema(),
+		IDField: "functionArnWithVersion",
+		// A function version is a snapshot of a function that will usually be
+		// referenced by an alias or used as the target of provisioned concurrency,
+		// it is not usually a terminal resource.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaFunctionVersionActions.GetExternalState,
+		CreateFunc:           lambdaFunctionVersionActions.Create,
+		UpdateFunc:           lambdaFunctionVersionActions.Update,
+		DestroyFunc:          lambdaFunctionVersionActions.Destroy,
+		StabilisedFunc:       lambdaFunctionVersionActions.Stabilised,
+	}
+}
+
+type lambdaFunctionVersionResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaFunctionVersionResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
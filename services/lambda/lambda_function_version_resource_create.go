@@ -0,0 +1,96 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaFunctionVersionResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&functionVersionPublish{},
+		&provisionedConcurrencyConfigUpdate{},
+		&functionVersionRuntimePolicyUpdate{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during function version creation")
+	}
+
+	publishVersionOutput, ok := saveOpCtx.Data["publishVersionOutput"].(*lambda.PublishVersionOutput)
+	if !ok {
+		return nil, fmt.Errorf("publishVersionOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.functionArnWithVersion": core.MappingNodeFromString(
+				aws.ToString(publishVersionOutput.FunctionArn),
+			),
+			"spec.version": core.MappingNodeFromString(
+				aws.ToString(publishVersionOutput.Version),
+			),
+		},
+	}, nil
+}
+
+func changesToPublishVersionInput(
+	specData *core.MappingNode,
+) (*lambda.PublishVersionInput, bool) {
+	input := &lambda.PublishVersionInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.PublishVersionInput]{
+		pluginutils.NewValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.PublishVersionInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.codeSha256",
+			func(value *core.MappingNode, input *lambda.PublishVersionInput) {
+				input.CodeSha256 = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.description",
+			func(value *core.MappingNode, input *lambda.PublishVersionInput) {
+				input.Description = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
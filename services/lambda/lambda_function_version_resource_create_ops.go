@@ -0,0 +1,53 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type functionVersionPublish struct {
+	input *lambda.PublishVersionInput
+}
+
+func (u *functionVersionPublish) Name() string {
+	return "publish function version"
+}
+
+func (u *functionVersionPublish) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToPublishVersionInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *functionVersionPublish) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	publishVersionOutput, err := lambdaService.PublishVersion(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(
+		publishVersionOutput.FunctionArn,
+	)
+	newSaveOpCtx.Data["publishVersionOutput"] = publishVersionOutput
+	newSaveOpCtx.Data["functionName"] = aws.ToString(u.input.FunctionName)
+	newSaveOpCtx.Data["qualifier"] = aws.ToString(publishVersionOutput.Version)
+
+	return newSaveOpCtx, nil
+}
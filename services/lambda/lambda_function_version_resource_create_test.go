@@ -0,0 +1,286 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionVersionResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionVersionResourceCreateSuite) Test_create_lambda_function_version() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createBasicFunctionVersionCreateTestCase(providerCtx, loader),
+		createFunctionVersionWithVersionConfigsTestCase(providerCtx, loader),
+		createFunctionVersionFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		FunctionVersionResource,
+		&s.Suite,
+	)
+}
+
+func createBasicFunctionVersionCreateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	versionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:3"
+
+	service := createLambdaServiceMock(
+		WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(versionARN),
+			Version:     aws.String("3"),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"description":  core.MappingNodeFromString("Release v3"),
+			"codeSha256":   core.MappingNodeFromString("YmFzZTY0LWVuY29kZWQtc2hhMjU2"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "publish basic function version",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.description",
+					},
+					{
+						FieldPath: "spec.codeSha256",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArnWithVersion": core.MappingNodeFromString(versionARN),
+				"spec.version":                core.MappingNodeFromString("3"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishVersion": &lambda.PublishVersionInput{
+				FunctionName: aws.String("test-function"),
+				Description:  aws.String("Release v3"),
+				CodeSha256:   aws.String("YmFzZTY0LWVuY29kZWQtc2hhMjU2"),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PutProvisionedConcurrencyConfig",
+			"PutRuntimeManagementConfig",
+		},
+	}
+}
+
+func createFunctionVersionWithVersionConfigsTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	versionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:4"
+
+	service := createLambdaServiceMock(
+		WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn:  aws.String(versionARN),
+			FunctionName: aws.String("test-function"),
+			Version:      aws.String("4"),
+		}),
+		WithPutProvisionedConcurrencyConfigOutput(&lambda.PutProvisionedConcurrencyConfigOutput{}),
+		WithPutRuntimeManagementConfigOutput(&lambda.PutRuntimeManagementConfigOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(10),
+				},
+			},
+			"runtimePolicy": {
+				Fields: map[string]*core.MappingNode{
+					"updateRuntimeOn": core.MappingNodeFromString("Manual"),
+					"runtimeVersionArn": core.MappingNodeFromString(
+						"arn:aws:lambda:us-west-2::runtime:abcd1234",
+					),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "publish function version with provisioned concurrency and runtime policy",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.provisionedConcurrencyConfig.provisionedConcurrentExecutions",
+					},
+					{
+						FieldPath: "spec.runtimePolicy.updateRuntimeOn",
+					},
+					{
+						FieldPath: "spec.runtimePolicy.runtimeVersionArn",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArnWithVersion": core.MappingNodeFromString(versionARN),
+				"spec.version":                core.MappingNodeFromString("4"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishVersion": &lambda.PublishVersionInput{
+				FunctionName: aws.String("test-function"),
+			},
+			"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+				FunctionName:                    aws.String("test-function"),
+				Qualifier:                       aws.String("4"),
+				ProvisionedConcurrentExecutions: aws.Int32(10),
+			},
+			"PutRuntimeManagementConfig": &lambda.PutRuntimeManagementConfigInput{
+				FunctionName:      aws.String("test-function"),
+				Qualifier:         aws.String("4"),
+				UpdateRuntimeOn:   types.UpdateRuntimeOnManual,
+				RuntimeVersionArn: aws.String("arn:aws:lambda:us-west-2::runtime:abcd1234"),
+			},
+		},
+	}
+}
+
+func createFunctionVersionFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPublishVersionError(errors.New("failed to publish version")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "publish function version failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaFunctionVersionResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionVersionResourceCreateSuite))
+}
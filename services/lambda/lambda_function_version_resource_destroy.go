@@ -0,0 +1,54 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaFunctionVersionResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	// Published versions are retained by default so that they remain
+	// available for rollbacks and any aliases that still reference them.
+	retainOnDestroy, hasRetainOnDestroy := pluginutils.GetValueByPath(
+		"$.retainOnDestroy",
+		input.ResourceState.SpecData,
+	)
+	if !hasRetainOnDestroy || core.BoolValue(retainOnDestroy) {
+		return nil
+	}
+
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceState.SpecData.Fields["functionName"],
+	)
+	version := core.StringValue(
+		input.ResourceState.SpecData.Fields["version"],
+	)
+	_, err = lambdaService.DeleteFunction(
+		ctx,
+		&lambda.DeleteFunctionInput{
+			FunctionName: &functionName,
+			Qualifier:    &version,
+		},
+	)
+	if err != nil {
+		// A version that has already been deleted outside of the blueprint
+		// should not prevent the rest of the blueprint from being destroyed.
+		if isFunctionNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
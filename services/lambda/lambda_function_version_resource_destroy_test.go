@@ -0,0 +1,143 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionVersionResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionVersionResourceDestroySuite) Test_destroy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		createFunctionVersionDestroyTestCase(
+			"retains function version by default",
+			providerCtx,
+			loader,
+			// Deleting the function would fail, so the destroy operation
+			// only succeeds if the version is retained.
+			createLambdaServiceMock(
+				WithDeleteFunctionError(errors.New("failed to delete function version")),
+			),
+			nil,
+			false,
+		),
+		createFunctionVersionDestroyTestCase(
+			"retains function version when retainOnDestroy is true",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteFunctionError(errors.New("failed to delete function version")),
+			),
+			core.MappingNodeFromBool(true),
+			false,
+		),
+		createFunctionVersionDestroyTestCase(
+			"deletes function version when retainOnDestroy is false",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteFunctionOutput(&lambda.DeleteFunctionOutput{}),
+			),
+			core.MappingNodeFromBool(false),
+			false,
+		),
+		createFunctionVersionDestroyTestCase(
+			"succeeds when function version has already been deleted",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteFunctionError(&types.ResourceNotFoundException{
+					Message: aws.String("Function not found: test-function:3"),
+				}),
+			),
+			core.MappingNodeFromBool(false),
+			false,
+		),
+		createFunctionVersionDestroyTestCase(
+			"fails to delete function version",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteFunctionError(errors.New("failed to delete function version")),
+			),
+			core.MappingNodeFromBool(false),
+			true,
+		),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		FunctionVersionResource,
+		&s.Suite,
+	)
+}
+
+func createFunctionVersionDestroyTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	service *lambdaServiceMock,
+	retainOnDestroy *core.MappingNode,
+	expectError bool,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"functionArnWithVersion": core.MappingNodeFromString(
+				"arn:aws:lambda:us-east-1:123456789012:function:test-function:3",
+			),
+			"version": core.MappingNodeFromString("3"),
+		},
+	}
+	if retainOnDestroy != nil {
+		specData.Fields["retainOnDestroy"] = retainOnDestroy
+	}
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: specData,
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
+func TestLambdaFunctionVersionResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionVersionResourceDestroySuite))
+}
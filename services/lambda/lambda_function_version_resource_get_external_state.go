@@ -0,0 +1,214 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaFunctionVersionResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.CurrentResourceSpec.Fields["functionName"],
+	)
+	version := core.StringValue(
+		input.CurrentResourceSpec.Fields["version"],
+	)
+
+	versionConfig, err := l.findFunctionVersion(
+		ctx,
+		functionName,
+		version,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(
+		functionName,
+		versionConfig,
+		input.CurrentResourceSpec,
+	)
+
+	err = l.addAdditionalConfigurationsToSpec(
+		ctx,
+		functionName,
+		version,
+		input.CurrentResourceSpec,
+		resourceSpecState.Fields,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaFunctionVersionResourceActions) findFunctionVersion(
+	ctx context.Context,
+	functionName string,
+	version string,
+	lambdaService Service,
+) (*types.FunctionConfiguration, error) {
+	var marker *string
+	for {
+		listVersionsOutput, err := lambdaService.ListVersionsByFunction(
+			ctx,
+			&lambda.ListVersionsByFunctionInput{
+				FunctionName: &functionName,
+				Marker:       marker,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, versionConfig := range listVersionsOutput.Versions {
+			if aws.ToString(versionConfig.Version) == version {
+				return &versionConfig, nil
+			}
+		}
+
+		if listVersionsOutput.NextMarker == nil {
+			return nil, fmt.Errorf(
+				"version %q of function %q could not be found",
+				version,
+				functionName,
+			)
+		}
+		marker = listVersionsOutput.NextMarker
+	}
+}
+
+func (l *lambdaFunctionVersionResourceActions) buildBaseResourceSpecState(
+	functionName string,
+	versionConfig *types.FunctionConfiguration,
+	currentResourceSpec *core.MappingNode,
+) *core.MappingNode {
+	fields := map[string]*core.MappingNode{
+		"functionName": core.MappingNodeFromString(functionName),
+		"functionArnWithVersion": core.MappingNodeFromString(
+			aws.ToString(versionConfig.FunctionArn),
+		),
+		"version": core.MappingNodeFromString(
+			aws.ToString(versionConfig.Version),
+		),
+	}
+
+	// The code hash and description of a version are always populated in AWS
+	// but are optional inputs that trigger a new version to be published when
+	// changed, so they are only included when they are set in the current spec
+	// to avoid reporting drift for values that were never provided.
+	if _, hasCodeSha256 := currentResourceSpec.Fields["codeSha256"]; hasCodeSha256 {
+		fields["codeSha256"] = core.MappingNodeFromString(
+			aws.ToString(versionConfig.CodeSha256),
+		)
+	}
+
+	if _, hasDescription := currentResourceSpec.Fields["description"]; hasDescription {
+		fields["description"] = core.MappingNodeFromString(
+			aws.ToString(versionConfig.Description),
+		)
+	}
+
+	// Retention is a provider-side behaviour that is not persisted in AWS,
+	// so like other input-only fields, it is sourced from the current spec.
+	if retainOnDestroy, hasRetainOnDestroy := currentResourceSpec.Fields["retainOnDestroy"]; hasRetainOnDestroy {
+		fields["retainOnDestroy"] = retainOnDestroy
+	}
+
+	return &core.MappingNode{
+		Fields: fields,
+	}
+}
+
+func (l *lambdaFunctionVersionResourceActions) addAdditionalConfigurationsToSpec(
+	ctx context.Context,
+	functionName string,
+	version string,
+	currentResourceSpec *core.MappingNode,
+	specFields map[string]*core.MappingNode,
+	lambdaService Service,
+) error {
+	err := addProvisionedConcurrencyConfigToSpec(
+		ctx,
+		functionName,
+		version,
+		specFields,
+		lambdaService,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add provisioned concurrency config: %w", err)
+	}
+
+	// The runtime management configuration for a version always has a value in AWS
+	// (defaulting to "Auto"), only report it when the version has a runtime policy
+	// in the current spec.
+	if _, hasRuntimePolicy := currentResourceSpec.Fields["runtimePolicy"]; !hasRuntimePolicy {
+		return nil
+	}
+
+	err = l.addRuntimePolicyToSpec(
+		ctx,
+		functionName,
+		version,
+		specFields,
+		lambdaService,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add runtime policy: %w", err)
+	}
+
+	return nil
+}
+
+func (l *lambdaFunctionVersionResourceActions) addRuntimePolicyToSpec(
+	ctx context.Context,
+	functionName string,
+	version string,
+	specFields map[string]*core.MappingNode,
+	lambdaService Service,
+) error {
+	runtimeMgmtConfigOutput, err := lambdaService.GetRuntimeManagementConfig(
+		ctx,
+		&lambda.GetRuntimeManagementConfigInput{
+			FunctionName: &functionName,
+			Qualifier:    &version,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]*core.MappingNode{
+		"updateRuntimeOn": core.MappingNodeFromString(
+			string(runtimeMgmtConfigOutput.UpdateRuntimeOn),
+		),
+	}
+	if runtimeMgmtConfigOutput.RuntimeVersionArn != nil {
+		fields["runtimeVersionArn"] = core.MappingNodeFromString(
+			aws.ToString(runtimeMgmtConfigOutput.RuntimeVersionArn),
+		)
+	}
+	specFields["runtimePolicy"] = &core.MappingNode{
+		Fields: fields,
+	}
+
+	return nil
+}
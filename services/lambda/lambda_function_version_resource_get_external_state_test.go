@@ -0,0 +1,238 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionVersionResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionVersionResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createBasicFunctionVersionStateTestCase(providerCtx, loader),
+		createFunctionVersionWithConfigsStateTestCase(providerCtx, loader),
+		createFunctionVersionNotFoundStateTestCase(providerCtx, loader),
+		createListVersionsErrorStateTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		FunctionVersionResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaFunctionVersionResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionVersionResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createTestFunctionVersionsOutput() *lambda.ListVersionsByFunctionOutput {
+	return &lambda.ListVersionsByFunctionOutput{
+		Versions: []types.FunctionConfiguration{
+			{
+				FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:test-function:$LATEST"),
+				Version:     aws.String("$LATEST"),
+				CodeSha256:  aws.String("bGF0ZXN0LWNvZGUtc2hhMjU2"),
+			},
+			{
+				FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:test-function:3"),
+				Version:     aws.String("3"),
+				CodeSha256:  aws.String("cHVibGlzaGVkLWNvZGUtc2hhMjU2"),
+				Description: aws.String("Release v3"),
+			},
+		},
+	}
+}
+
+func createBasicFunctionVersionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets basic function version state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithListVersionsByFunctionOutput(createTestFunctionVersionsOutput()),
+			WithGetProvisionedConcurrencyConfigError(
+				&types.ProvisionedConcurrencyConfigNotFoundException{
+					Message: aws.String("No Provisioned Concurrency Config found for this function"),
+				},
+			),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":    core.MappingNodeFromString("test-function"),
+					"version":         core.MappingNodeFromString("3"),
+					"retainOnDestroy": core.MappingNodeFromBool(true),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"functionArnWithVersion": core.MappingNodeFromString(
+						"arn:aws:lambda:us-west-2:123456789012:function:test-function:3",
+					),
+					"version":         core.MappingNodeFromString("3"),
+					"retainOnDestroy": core.MappingNodeFromBool(true),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createFunctionVersionWithConfigsStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets function version state with version-specific configuration",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithListVersionsByFunctionOutput(createTestFunctionVersionsOutput()),
+			WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+				RequestedProvisionedConcurrentExecutions: aws.Int32(10),
+			}),
+			WithGetRuntimeManagementConfigOutput(&lambda.GetRuntimeManagementConfigOutput{
+				UpdateRuntimeOn: types.UpdateRuntimeOnAuto,
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"version":      core.MappingNodeFromString("3"),
+					"codeSha256":   core.MappingNodeFromString("cHVibGlzaGVkLWNvZGUtc2hhMjU2"),
+					"description":  core.MappingNodeFromString("Release v3"),
+					"provisionedConcurrencyConfig": {
+						Fields: map[string]*core.MappingNode{
+							"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+						},
+					},
+					"runtimePolicy": {
+						Fields: map[string]*core.MappingNode{
+							"updateRuntimeOn": core.MappingNodeFromString("FunctionUpdate"),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"functionArnWithVersion": core.MappingNodeFromString(
+						"arn:aws:lambda:us-west-2:123456789012:function:test-function:3",
+					),
+					"version":     core.MappingNodeFromString("3"),
+					"codeSha256":  core.MappingNodeFromString("cHVibGlzaGVkLWNvZGUtc2hhMjU2"),
+					"description": core.MappingNodeFromString("Release v3"),
+					"provisionedConcurrencyConfig": {
+						Fields: map[string]*core.MappingNode{
+							"provisionedConcurrentExecutions": core.MappingNodeFromInt(10),
+						},
+					},
+					"runtimePolicy": {
+						Fields: map[string]*core.MappingNode{
+							"updateRuntimeOn": core.MappingNodeFromString("Auto"),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createFunctionVersionNotFoundStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "fails when function version no longer exists",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithListVersionsByFunctionOutput(createTestFunctionVersionsOutput()),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"version":      core.MappingNodeFromString("7"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
+
+func createListVersionsErrorStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles list versions error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithListVersionsByFunctionError(errors.New("failed to list versions")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"version":      core.MappingNodeFromString("3"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,105 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaFunctionVersionResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaFunctionVersionDefinition",
+		Description: "The definition of a published version of an AWS Lambda function.",
+		Required:    []string{"functionName"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function to publish a version for. " +
+					"This can be the function name, the function ARN or a partial ARN.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"codeSha256": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "Only publish a version if the hash value matches the value that's specified. " +
+					"Use this option to avoid publishing a version if the function code has changed since you last updated it.",
+				MustRecreate: true,
+			},
+			"description": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "A description for the version to override the description in the function configuration.",
+				MaxLength:    256,
+				MustRecreate: true,
+			},
+			"provisionedConcurrencyConfig": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "ProvisionedConcurrencyConfig",
+				Description: "The provisioned concurrency configuration for the function version.",
+				FormattedDescription: "The [provisioned concurrency](https://docs.aws.amazon.com/lambda/latest/dg/provisioned-concurrency.html) " +
+					"configuration for the function version.",
+				Required: []string{"provisionedConcurrentExecutions"},
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"provisionedConcurrentExecutions": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The amount of provisioned concurrency to allocate for the version.",
+						Minimum:     core.ScalarFromInt(1),
+					},
+				},
+			},
+			"runtimePolicy": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "RuntimePolicy",
+				Required:    []string{"updateRuntimeOn"},
+				Description: "Sets the runtime management configuration for the function version.",
+				FormattedDescription: "Sets the runtime management configuration for the function version. " +
+					"For more information, see [Runtime updates](https://docs.aws.amazon.com/lambda/latest/dg/runtimes-update.html).",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"runtimeVersionArn": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The ARN of the runtime version you want the function version to use.",
+						FormattedDescription: "The ARN of the runtime version you want the function version to use.\n\n" +
+							"> [!NOTE]\n" +
+							"> This is only required if you're using the **Manual** runtime update mode.",
+						Pattern:   "^arn:(aws[a-zA-Z-]*):lambda:[a-z]{2}((-gov)|(-iso(b?)))?-[a-z]+-\\d{1}::runtime:.+$",
+						MinLength: 26,
+						MaxLength: 2048,
+					},
+					"updateRuntimeOn": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The runtime update mode to use.",
+						AllowedValues: []*core.MappingNode{
+							core.MappingNodeFromString("Auto"),
+							core.MappingNodeFromString("FunctionUpdate"),
+							core.MappingNodeFromString("Manual"),
+						},
+					},
+				},
+			},
+			"retainOnDestroy": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether or not to retain the published version in AWS when the resource is destroyed. " +
+					"Published versions are retained by default so that previous versions remain available for rollbacks " +
+					"and for aliases that still reference them.",
+				Default:  core.MappingNodeFromBool(true),
+				Nullable: true,
+			},
+
+			// Computed fields
+			"functionArnWithVersion": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the function version, qualified with the version number.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The version number of the published function version.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionVersionResourceStabilisedSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionVersionResourceStabilisedSuite) Test_stabilised() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	resourceSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"version":      core.MappingNodeFromString("3"),
+		},
+	}
+
+	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, Service]{
+		{
+			Name: "returns stabilised when function version is active",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State: types.StateActive,
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    resourceSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: true,
+			},
+		},
+		{
+			Name: "returns not stabilised when function version is pending",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State: types.StatePending,
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    resourceSpec,
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+		},
+		{
+			Name: "fails when function version cannot be retrieved",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetFunctionError(errors.New("failed to get function")),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    resourceSpec,
+			},
+			ExpectError: true,
+		},
+	}
+
+	plugintestutils.RunResourceHasStabilisedTestCases(
+		testCases,
+		FunctionVersionResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaFunctionVersionResourceStabilisedSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionVersionResourceStabilisedSuite))
+}
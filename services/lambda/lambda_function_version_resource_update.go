@@ -0,0 +1,81 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaFunctionVersionResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// functionArnWithVersion is the ID field that must be present in order to update the resource,
+	// the version and function name are needed to target the version-specific configuration.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	functionArnWithVersion, err := core.GetPathValue(
+		"$.functionArnWithVersion",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := core.GetPathValue(
+		"$.version",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName, err := core.GetPathValue(
+		"$.functionName",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Published versions are immutable, only the version-specific
+	// configuration for provisioned concurrency and the runtime policy
+	// can be updated in place.
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&provisionedConcurrencyConfigUpdate{},
+		&functionVersionRuntimePolicyUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(functionArnWithVersion),
+			Data: map[string]any{
+				"functionName": core.StringValue(functionName),
+				"qualifier":    core.StringValue(version),
+			},
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.functionArnWithVersion": functionArnWithVersion,
+			"spec.version":                version,
+		},
+	}, nil
+}
@@ -0,0 +1,49 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type functionVersionRuntimePolicyUpdate struct {
+	input *lambda.PutRuntimeManagementConfigInput
+}
+
+func (u *functionVersionRuntimePolicyUpdate) Name() string {
+	return "function version runtime policy"
+}
+
+func (u *functionVersionRuntimePolicyUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	functionName, version := functionQualifierFromSaveOpContext(saveOpCtx)
+	runtimePolicyData, _ := pluginutils.GetValueByPath(
+		"$.runtimePolicy",
+		specData,
+	)
+	input, hasUpdates := changesToPutRuntimeMgmtConfigInput(
+		functionName,
+		runtimePolicyData,
+		changes,
+		"spec.runtimePolicy",
+	)
+	input.Qualifier = aws.String(version)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *functionVersionRuntimePolicyUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.PutRuntimeManagementConfig(ctx, u.input)
+	return saveOpCtx, err
+}
@@ -0,0 +1,321 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionVersionResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionVersionResourceUpdateSuite) Test_update_lambda_function_version() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createFunctionVersionConfigsUpdateTestCase(providerCtx, loader),
+		createFunctionVersionRemoveProvisionedConcurrencyTestCase(providerCtx, loader),
+		createFunctionVersionUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		FunctionVersionResource,
+		&s.Suite,
+	)
+}
+
+func createFunctionVersionConfigsUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	versionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:3"
+
+	service := createLambdaServiceMock(
+		WithPutProvisionedConcurrencyConfigOutput(&lambda.PutProvisionedConcurrencyConfigOutput{}),
+		WithPutRuntimeManagementConfigOutput(&lambda.PutRuntimeManagementConfigOutput{}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":           core.MappingNodeFromString("test-function"),
+			"functionArnWithVersion": core.MappingNodeFromString(versionARN),
+			"version":                core.MappingNodeFromString("3"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(20),
+				},
+			},
+			"runtimePolicy": {
+				Fields: map[string]*core.MappingNode{
+					"updateRuntimeOn": core.MappingNodeFromString("FunctionUpdate"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update function version provisioned concurrency and runtime policy",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-version-id",
+						Name:       "TestFunctionVersion",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.provisionedConcurrencyConfig.provisionedConcurrentExecutions",
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.runtimePolicy.updateRuntimeOn",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArnWithVersion": core.MappingNodeFromString(versionARN),
+				"spec.version":                core.MappingNodeFromString("3"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+				FunctionName:                    aws.String("test-function"),
+				Qualifier:                       aws.String("3"),
+				ProvisionedConcurrentExecutions: aws.Int32(20),
+			},
+			"PutRuntimeManagementConfig": &lambda.PutRuntimeManagementConfigInput{
+				FunctionName:    aws.String("test-function"),
+				Qualifier:       aws.String("3"),
+				UpdateRuntimeOn: types.UpdateRuntimeOnFunctionUpdate,
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PublishVersion",
+			"DeleteProvisionedConcurrencyConfig",
+		},
+	}
+}
+
+func createFunctionVersionRemoveProvisionedConcurrencyTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	versionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:3"
+
+	service := createLambdaServiceMock(
+		WithDeleteProvisionedConcurrencyConfigOutput(&lambda.DeleteProvisionedConcurrencyConfigOutput{}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":           core.MappingNodeFromString("test-function"),
+			"functionArnWithVersion": core.MappingNodeFromString(versionARN),
+			"version":                core.MappingNodeFromString("3"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "remove function version provisioned concurrency",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-version-id",
+						Name:       "TestFunctionVersion",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				RemovedFields: []string{
+					"spec.provisionedConcurrencyConfig",
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArnWithVersion": core.MappingNodeFromString(versionARN),
+				"spec.version":                core.MappingNodeFromString("3"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"DeleteProvisionedConcurrencyConfig": &lambda.DeleteProvisionedConcurrencyConfigInput{
+				FunctionName: aws.String("test-function"),
+				Qualifier:    aws.String("3"),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PublishVersion",
+			"PutProvisionedConcurrencyConfig",
+			"PutRuntimeManagementConfig",
+		},
+	}
+}
+
+func createFunctionVersionUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	versionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:3"
+
+	service := createLambdaServiceMock(
+		WithPutProvisionedConcurrencyConfigError(errors.New("failed to put provisioned concurrency config")),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":           core.MappingNodeFromString("test-function"),
+			"functionArnWithVersion": core.MappingNodeFromString(versionARN),
+			"version":                core.MappingNodeFromString("3"),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update function version failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-version-id",
+						Name:       "TestFunctionVersion",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.provisionedConcurrencyConfig.provisionedConcurrentExecutions",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaFunctionVersionResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionVersionResourceUpdateSuite))
+}
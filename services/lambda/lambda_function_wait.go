@@ -0,0 +1,179 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+// functionWaitConfig determines how often the state of a function
+// is polled while waiting for an operation on the function to complete.
+type functionWaitConfig struct {
+	// The delay before the first retry, this is doubled
+	// for each subsequent attempt up to maxDelay.
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	// The maximum amount of time to wait for the operation to complete.
+	timeout time.Duration
+}
+
+var defaultFunctionUpdateWaitConfig = &functionWaitConfig{
+	initialDelay: 1 * time.Second,
+	maxDelay:     10 * time.Second,
+	timeout:      5 * time.Minute,
+}
+
+var defaultFunctionDeletionWaitConfig = &functionWaitConfig{
+	initialDelay: 2 * time.Second,
+	maxDelay:     15 * time.Second,
+	timeout:      10 * time.Minute,
+}
+
+// waitForFunctionUpdateToComplete polls the function until it is no longer
+// being created and the last update is no longer in progress.
+// Lambda rejects changes to a function with a ResourceConflictException while
+// an update is in progress, so this must be called between operations
+// that update the configuration or code of the same function.
+func waitForFunctionUpdateToComplete(
+	ctx context.Context,
+	lambdaService Service,
+	functionName string,
+	waitConfig *functionWaitConfig,
+) error {
+	return pollFunction(
+		ctx,
+		waitConfig,
+		fmt.Sprintf("the last update to function %q to complete", functionName),
+		func(ctx context.Context) (bool, error) {
+			output, err := lambdaService.GetFunction(
+				ctx,
+				&lambda.GetFunctionInput{
+					FunctionName: aws.String(functionName),
+				},
+			)
+			if err != nil {
+				return false, fmt.Errorf(
+					"failed to check the status of the last update to function %q: %w",
+					functionName,
+					err,
+				)
+			}
+
+			configuration := output.Configuration
+			if configuration != nil &&
+				configuration.LastUpdateStatus == types.LastUpdateStatusFailed {
+				return false, functionStabilisationError(
+					functionName,
+					"the last update to the function failed",
+					string(configuration.LastUpdateStatusReasonCode),
+					aws.ToString(configuration.LastUpdateStatusReason),
+				)
+			}
+
+			return configuration == nil ||
+				(configuration.State != types.StatePending &&
+					configuration.LastUpdateStatus != types.LastUpdateStatusInProgress), nil
+		},
+	)
+}
+
+// waitForFunctionToBeDeleted polls the function until it can no longer
+// be found.
+// Functions connected to a VPC hold on to network interfaces until
+// the function has been deleted, waiting for the function to disappear
+// prevents subnets and security groups from being deleted while they are
+// still in use by the function.
+func waitForFunctionToBeDeleted(
+	ctx context.Context,
+	lambdaService Service,
+	functionName string,
+	waitConfig *functionWaitConfig,
+) error {
+	return pollFunction(
+		ctx,
+		waitConfig,
+		fmt.Sprintf("function %q to be deleted", functionName),
+		func(ctx context.Context) (bool, error) {
+			_, err := lambdaService.GetFunction(
+				ctx,
+				&lambda.GetFunctionInput{
+					FunctionName: aws.String(functionName),
+				},
+			)
+			if err == nil {
+				return false, nil
+			}
+
+			if isFunctionNotFoundError(err) {
+				return true, nil
+			}
+
+			return false, fmt.Errorf(
+				"failed to check whether function %q has been deleted: %w",
+				functionName,
+				err,
+			)
+		},
+	)
+}
+
+// pollFunction calls the provided check function with backoff until
+// it reports that the operation being waited on is complete,
+// the check fails, the wait times out or the context is cancelled.
+func pollFunction(
+	ctx context.Context,
+	waitConfig *functionWaitConfig,
+	waitingFor string,
+	check func(ctx context.Context) (bool, error),
+) error {
+	waitCtx, cancel := context.WithTimeout(ctx, waitConfig.timeout)
+	defer cancel()
+
+	delay := waitConfig.initialDelay
+	for {
+		done, err := check(waitCtx)
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf(
+				"timed out after %s waiting for %s",
+				waitConfig.timeout,
+				waitingFor,
+			)
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, waitConfig.maxDelay)
+	}
+}
+
+func isFunctionNotFoundError(err error) bool {
+	var notFoundErr *types.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
+}
+
+// functionUpdateError marks errors caused by a conflicting update
+// to a function as retryable, these occur when an update is made
+// while another update to the function is still in progress.
+func functionUpdateError(err error) error {
+	var conflictErr *types.ResourceConflictException
+	if errors.As(err, &conflictErr) {
+		return &provider.RetryableError{
+			ChildError: err,
+		}
+	}
+
+	return err
+}
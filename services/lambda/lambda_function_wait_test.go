@@ -0,0 +1,189 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionWaitSuite struct {
+	suite.Suite
+}
+
+var testFunctionWaitConfig = &functionWaitConfig{
+	initialDelay: 1 * time.Millisecond,
+	maxDelay:     5 * time.Millisecond,
+	timeout:      1 * time.Second,
+}
+
+func (s *LambdaFunctionWaitSuite) Test_waits_for_update_in_progress_to_complete() {
+	service := createLambdaServiceMock(
+		WithGetFunctionOutputSequence(
+			functionWithLastUpdateStatus(types.LastUpdateStatusInProgress),
+			functionWithLastUpdateStatus(types.LastUpdateStatusInProgress),
+		),
+		WithGetFunctionOutput(functionWithLastUpdateStatus(types.LastUpdateStatusSuccessful)),
+	)
+
+	err := waitForFunctionUpdateToComplete(
+		context.Background(),
+		service,
+		"test-function",
+		testFunctionWaitConfig,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(service.getFunctionOutputSequence)
+}
+
+func (s *LambdaFunctionWaitSuite) Test_waits_for_pending_function_to_be_created() {
+	pendingOutput := functionWithLastUpdateStatus(types.LastUpdateStatusSuccessful)
+	pendingOutput.Configuration.State = types.StatePending
+	service := createLambdaServiceMock(
+		WithGetFunctionOutputSequence(pendingOutput),
+		WithGetFunctionOutput(functionWithLastUpdateStatus(types.LastUpdateStatusSuccessful)),
+	)
+
+	err := waitForFunctionUpdateToComplete(
+		context.Background(),
+		service,
+		"test-function",
+		testFunctionWaitConfig,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(service.getFunctionOutputSequence)
+}
+
+func (s *LambdaFunctionWaitSuite) Test_fails_when_last_update_failed() {
+	output := functionWithLastUpdateStatus(types.LastUpdateStatusFailed)
+	output.Configuration.LastUpdateStatusReasonCode = types.LastUpdateStatusReasonCodeInvalidSubnet
+	output.Configuration.LastUpdateStatusReason = aws.String("The subnet does not exist.")
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(output),
+	)
+
+	err := waitForFunctionUpdateToComplete(
+		context.Background(),
+		service,
+		"test-function",
+		testFunctionWaitConfig,
+	)
+	s.Require().Error(err)
+	deployErr, isDeployErr := err.(*provider.ResourceDeployError)
+	s.Require().True(isDeployErr)
+	s.Assert().Equal(
+		[]string{
+			"the last update to the function failed for \"test-function\" " +
+				"[InvalidSubnet]: The subnet does not exist.",
+		},
+		deployErr.FailureReasons,
+	)
+}
+
+func (s *LambdaFunctionWaitSuite) Test_times_out_when_update_does_not_complete() {
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(functionWithLastUpdateStatus(types.LastUpdateStatusInProgress)),
+	)
+
+	err := waitForFunctionUpdateToComplete(
+		context.Background(),
+		service,
+		"test-function",
+		&functionWaitConfig{
+			initialDelay: 1 * time.Millisecond,
+			maxDelay:     2 * time.Millisecond,
+			timeout:      20 * time.Millisecond,
+		},
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "waiting for the last update to function \"test-function\" to complete")
+}
+
+func (s *LambdaFunctionWaitSuite) Test_stops_waiting_when_context_is_cancelled() {
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(functionWithLastUpdateStatus(types.LastUpdateStatusInProgress)),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := waitForFunctionUpdateToComplete(
+		ctx,
+		service,
+		"test-function",
+		testFunctionWaitConfig,
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorIs(err, context.Canceled)
+}
+
+func (s *LambdaFunctionWaitSuite) Test_waits_for_function_to_be_deleted() {
+	service := createLambdaServiceMock(
+		WithGetFunctionOutputSequence(
+			functionWithLastUpdateStatus(types.LastUpdateStatusSuccessful),
+			functionWithLastUpdateStatus(types.LastUpdateStatusSuccessful),
+		),
+		WithGetFunctionError(&types.ResourceNotFoundException{
+			Message: aws.String("Function not found"),
+		}),
+	)
+
+	err := waitForFunctionToBeDeleted(
+		context.Background(),
+		service,
+		"test-function",
+		testFunctionWaitConfig,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(service.getFunctionOutputSequence)
+}
+
+func (s *LambdaFunctionWaitSuite) Test_times_out_when_function_is_not_deleted() {
+	service := createLambdaServiceMock(
+		WithGetFunctionOutput(functionWithLastUpdateStatus(types.LastUpdateStatusSuccessful)),
+	)
+
+	err := waitForFunctionToBeDeleted(
+		context.Background(),
+		service,
+		"test-function",
+		&functionWaitConfig{
+			initialDelay: 1 * time.Millisecond,
+			maxDelay:     2 * time.Millisecond,
+			timeout:      20 * time.Millisecond,
+		},
+	)
+	s.Require().Error(err)
+	s.Assert().ErrorContains(err, "waiting for function \"test-function\" to be deleted")
+}
+
+func (s *LambdaFunctionWaitSuite) Test_marks_conflict_errors_as_retryable() {
+	err := functionUpdateError(&types.ResourceConflictException{
+		Message: aws.String("An update is in progress for resource"),
+	})
+	var retryableErr *provider.RetryableError
+	s.Assert().True(errors.As(err, &retryableErr))
+
+	otherErr := errors.New("access denied")
+	s.Assert().Equal(otherErr, functionUpdateError(otherErr))
+	s.Assert().NoError(functionUpdateError(nil))
+}
+
+func functionWithLastUpdateStatus(status types.LastUpdateStatus) *lambda.GetFunctionOutput {
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			FunctionArn:      aws.String("arn:aws:lambda:us-west-2:123456789012:function:test-function"),
+			State:            types.StateActive,
+			LastUpdateStatus: status,
+		},
+	}
+}
+
+func TestLambdaFunctionWaitSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionWaitSuite))
+}
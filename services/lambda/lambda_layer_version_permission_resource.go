@@ -0,0 +1,92 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// LayerVersionPermissionResource returns a resource implementation for a statement
+// in the resource-based policy of a version of an AWS Lambda layer.
+func LayerVersionPermissionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_layer_version_permission_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_layer_version_permission_jsonc.md")
+	orgYAMLExample, _ := examples.ReadFile("examples/resources/lambda_layer_version_permission_org_yaml.md")
+
+	lambdaLayerVersionPermissionActions := &lambdaLayerVersionPermissionResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/layerVersionPermission",
+		Label:            "AWS Lambda Layer Version Permission",
+		PlainTextSummary: "A resource for granting other AWS accounts permission to use a version of an AWS Lambda layer.",
+		FormattedDescription: "The resource type used to define a statement in the resource-based policy of a " +
+			"[Lambda layer version](https://docs.aws.amazon.com/lambda/latest/dg/permissions-layer-cross-account.html). " +
+			"This is used to share a layer version with a single AWS account, all accounts in an organization " +
+			"or all AWS accounts.",
+		Schema:  lambdaLayerVersionPermissionResourceSchema(),
+		IDField: "id",
+		// A layer version permission grants access to a layer version for other accounts,
+		// it is a terminal resource that other resources will not usually depend on.
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+			string(orgYAMLExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaLayerVersionPermissionActions.GetExternalState,
+		CreateFunc:           lambdaLayerVersionPermissionActions.Create,
+		UpdateFunc:           lambdaLayerVersionPermissionActions.Update,
+		DestroyFunc:          lambdaLayerVersionPermissionActions.Destroy,
+		StabilisedFunc:       lambdaLayerVersionPermissionActions.Stabilised,
+	}
+}
+
+type lambdaLayerVersionPermissionResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaLayerVersionPermissionResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
+
+// layerVersionFromARN splits a layer version ARN into the ARN of the layer
+// and the version number, the Lambda API accepts the ARN of the layer
+// in place of the layer name for layer version operations.
+func layerVersionFromARN(layerVersionARN string) (string, int64, error) {
+	separatorIndex := strings.LastIndex(layerVersionARN, ":")
+	if separatorIndex == -1 || !strings.Contains(layerVersionARN, ":layer:") {
+		return "", 0, fmt.Errorf("%q is not a valid layer version ARN", layerVersionARN)
+	}
+
+	version, err := strconv.ParseInt(layerVersionARN[separatorIndex+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf(
+			"%q is not a valid layer version ARN, the ARN must end with a version number",
+			layerVersionARN,
+		)
+	}
+
+	return layerVersionARN[:separatorIndex], version, nil
+}
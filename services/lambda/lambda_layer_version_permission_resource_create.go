@@ -0,0 +1,105 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaLayerVersionPermissionResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&layerVersionPermissionAdd{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{
+				"defaultStatementId": defaultPermissionStatementID(input.ResourceID),
+			},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during layer version permission creation")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(saveOpCtx.ProviderUpstreamID),
+		},
+	}, nil
+}
+
+func changesToAddLayerVersionPermissionInput(
+	specData *core.MappingNode,
+	defaultStatementID string,
+) (*lambda.AddLayerVersionPermissionInput, bool, error) {
+	input := &lambda.AddLayerVersionPermissionInput{
+		StatementId: aws.String(defaultStatementID),
+	}
+
+	layerVersionARN, hasLayerVersionARN := pluginutils.GetValueByPath("$.layerVersionArn", specData)
+	if !hasLayerVersionARN {
+		return input, false, nil
+	}
+
+	layerName, version, err := layerVersionFromARN(core.StringValue(layerVersionARN))
+	if err != nil {
+		return nil, false, err
+	}
+	input.LayerName = aws.String(layerName)
+	input.VersionNumber = aws.Int64(version)
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.AddLayerVersionPermissionInput]{
+		pluginutils.NewValueSetter(
+			"$.action",
+			func(value *core.MappingNode, input *lambda.AddLayerVersionPermissionInput) {
+				input.Action = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.principal",
+			func(value *core.MappingNode, input *lambda.AddLayerVersionPermissionInput) {
+				input.Principal = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.organizationId",
+			func(value *core.MappingNode, input *lambda.AddLayerVersionPermissionInput) {
+				input.OrganizationId = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.statementId",
+			func(value *core.MappingNode, input *lambda.AddLayerVersionPermissionInput) {
+				input.StatementId = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+	}
+
+	return input, true, nil
+}
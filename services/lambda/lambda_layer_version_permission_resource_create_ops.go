@@ -0,0 +1,53 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type layerVersionPermissionAdd struct {
+	input *lambda.AddLayerVersionPermissionInput
+}
+
+func (u *layerVersionPermissionAdd) Name() string {
+	return "add layer version permission"
+}
+
+func (u *layerVersionPermissionAdd) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	defaultStatementID, _ := saveOpCtx.Data["defaultStatementId"].(string)
+	input, hasValues, err := changesToAddLayerVersionPermissionInput(specData, defaultStatementID)
+	if err != nil {
+		return false, saveOpCtx, err
+	}
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *layerVersionPermissionAdd) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	addPermissionOutput, err := lambdaService.AddLayerVersionPermission(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(u.input.StatementId)
+	newSaveOpCtx.Data["addLayerVersionPermissionOutput"] = addPermissionOutput
+
+	return newSaveOpCtx, nil
+}
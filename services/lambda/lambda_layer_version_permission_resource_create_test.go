@@ -0,0 +1,245 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaLayerVersionPermissionResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaLayerVersionPermissionResourceCreateSuite) Test_create_lambda_layer_version_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createAccountLayerVersionPermissionTestCase(providerCtx, loader),
+		createOrganizationLayerVersionPermissionTestCase(providerCtx, loader),
+		createLayerVersionPermissionInvalidARNTestCase(providerCtx, loader),
+		createLayerVersionPermissionFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		LayerVersionPermissionResource,
+		&s.Suite,
+	)
+}
+
+func createAccountLayerVersionPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithAddLayerVersionPermissionOutput(&lambda.AddLayerVersionPermissionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerVersionArn": core.MappingNodeFromString(
+				"arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3",
+			),
+			"action":    core.MappingNodeFromString("lambda:GetLayerVersion"),
+			"principal": core.MappingNodeFromString("210987654321"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version permission for an account with a generated statement ID",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createLayerVersionPermissionDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("celerity-test-layer-permission-id"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"AddLayerVersionPermission": &lambda.AddLayerVersionPermissionInput{
+				LayerName:     aws.String("arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries"),
+				VersionNumber: aws.Int64(3),
+				StatementId:   aws.String("celerity-test-layer-permission-id"),
+				Action:        aws.String("lambda:GetLayerVersion"),
+				Principal:     aws.String("210987654321"),
+			},
+		},
+	}
+}
+
+func createOrganizationLayerVersionPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithAddLayerVersionPermissionOutput(&lambda.AddLayerVersionPermissionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerVersionArn": core.MappingNodeFromString(
+				"arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3",
+			),
+			"action":         core.MappingNodeFromString("lambda:GetLayerVersion"),
+			"principal":      core.MappingNodeFromString("*"),
+			"organizationId": core.MappingNodeFromString("o-a1b2c3d4e5"),
+			"statementId":    core.MappingNodeFromString("share-with-org"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version permission for an organization",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createLayerVersionPermissionDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("share-with-org"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"AddLayerVersionPermission": &lambda.AddLayerVersionPermissionInput{
+				LayerName:      aws.String("arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries"),
+				VersionNumber:  aws.Int64(3),
+				StatementId:    aws.String("share-with-org"),
+				Action:         aws.String("lambda:GetLayerVersion"),
+				Principal:      aws.String("*"),
+				OrganizationId: aws.String("o-a1b2c3d4e5"),
+			},
+		},
+	}
+}
+
+func createLayerVersionPermissionInvalidARNTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock()
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerVersionArn": core.MappingNodeFromString(
+				"arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries",
+			),
+			"action":    core.MappingNodeFromString("lambda:GetLayerVersion"),
+			"principal": core.MappingNodeFromString("210987654321"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version permission fails for layer ARN without a version",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createLayerVersionPermissionDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createLayerVersionPermissionFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithAddLayerVersionPermissionError(errors.New("failed to add layer version permission")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerVersionArn": core.MappingNodeFromString(
+				"arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3",
+			),
+			"action":    core.MappingNodeFromString("lambda:GetLayerVersion"),
+			"principal": core.MappingNodeFromString("210987654321"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version permission failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createLayerVersionPermissionDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createLayerVersionPermissionDeployInput(
+	specData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	newFields := []provider.FieldChange{}
+	for fieldName := range specData.Fields {
+		newFields = append(newFields, provider.FieldChange{
+			FieldPath: "spec." + fieldName,
+		})
+	}
+
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-layer-permission-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-layer-permission-id",
+				ResourceName: "TestLayerVersionPermission",
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/layerVersionPermission",
+					},
+					Spec: specData,
+				},
+			},
+			NewFields: newFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaLayerVersionPermissionResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaLayerVersionPermissionResourceCreateSuite))
+}
@@ -0,0 +1,40 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaLayerVersionPermissionResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	layerName, version, err := layerVersionFromARN(
+		core.StringValue(input.ResourceState.SpecData.Fields["layerVersionArn"]),
+	)
+	if err != nil {
+		return err
+	}
+
+	statementID := core.StringValue(
+		input.ResourceState.SpecData.Fields["id"],
+	)
+	_, err = lambdaService.RemoveLayerVersionPermission(
+		ctx,
+		&lambda.RemoveLayerVersionPermissionInput{
+			LayerName:     &layerName,
+			VersionNumber: &version,
+			StatementId:   &statementID,
+		},
+	)
+
+	return err
+}
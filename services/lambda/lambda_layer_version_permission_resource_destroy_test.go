@@ -0,0 +1,99 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaLayerVersionPermissionResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaLayerVersionPermissionResourceDestroySuite) Test_destroy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		createLayerVersionPermissionDestroyTestCase(
+			"successfully removes layer version permission",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithRemoveLayerVersionPermissionOutput(&lambda.RemoveLayerVersionPermissionOutput{}),
+			),
+			false,
+		),
+		createLayerVersionPermissionDestroyTestCase(
+			"fails to remove layer version permission",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithRemoveLayerVersionPermissionError(errors.New("failed to remove layer version permission")),
+			),
+			true,
+		),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		LayerVersionPermissionResource,
+		&s.Suite,
+	)
+}
+
+func createLayerVersionPermissionDestroyTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	service *lambdaServiceMock,
+	expectError bool,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"layerVersionArn": core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3"),
+						"action":          core.MappingNodeFromString("lambda:GetLayerVersion"),
+						"principal":       core.MappingNodeFromString("210987654321"),
+						"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+					},
+				},
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
+func TestLambdaLayerVersionPermissionResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LambdaLayerVersionPermissionResourceDestroySuite))
+}
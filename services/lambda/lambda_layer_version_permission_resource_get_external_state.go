@@ -0,0 +1,123 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaLayerVersionPermissionResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	layerVersionARN := core.StringValue(
+		input.CurrentResourceSpec.Fields["layerVersionArn"],
+	)
+	statementID := core.StringValue(
+		input.CurrentResourceSpec.Fields["id"],
+	)
+
+	layerName, version, err := layerVersionFromARN(layerVersionARN)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := l.getLayerVersionPolicy(ctx, layerName, version, lambdaService)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get resource-based policy for layer version %q: %w",
+			layerVersionARN,
+			err,
+		)
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(
+		layerVersionARN,
+		statementID,
+		input.CurrentResourceSpec,
+	)
+
+	// A statement that has been removed from the policy outside of
+	// the blueprint is reported without the permission fields so the
+	// revoked permission is surfaced as drift.
+	statement := policy.findStatement(statementID)
+	if statement != nil {
+		l.addStatementToSpec(statement, resourceSpecState.Fields)
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+// A layer version without any permissions does not have a policy,
+// this is treated as an empty policy document.
+func (l *lambdaLayerVersionPermissionResourceActions) getLayerVersionPolicy(
+	ctx context.Context,
+	layerName string,
+	version int64,
+	lambdaService Service,
+) (*policyDocument, error) {
+	policyOutput, err := lambdaService.GetLayerVersionPolicy(
+		ctx,
+		&lambda.GetLayerVersionPolicyInput{
+			LayerName:     &layerName,
+			VersionNumber: &version,
+		},
+	)
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return &policyDocument{}, nil
+		}
+		return nil, err
+	}
+
+	return parsePolicyDocument(aws.ToString(policyOutput.Policy))
+}
+
+func (l *lambdaLayerVersionPermissionResourceActions) buildBaseResourceSpecState(
+	layerVersionARN string,
+	statementID string,
+	currentResourceSpec *core.MappingNode,
+) *core.MappingNode {
+	fields := map[string]*core.MappingNode{
+		"layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+		"id":              core.MappingNodeFromString(statementID),
+	}
+
+	// The statement ID is used to locate the statement, it is sourced from
+	// the current spec as it is not a part of the statement that can be
+	// mapped back to the spec when it was derived from the resource ID.
+	if statementIDInSpec, hasStatementID := currentResourceSpec.Fields["statementId"]; hasStatementID {
+		fields["statementId"] = statementIDInSpec
+	}
+
+	return &core.MappingNode{
+		Fields: fields,
+	}
+}
+
+func (l *lambdaLayerVersionPermissionResourceActions) addStatementToSpec(
+	statement *policyStatement,
+	specFields map[string]*core.MappingNode,
+) {
+	specFields["action"] = core.MappingNodeFromString(statement.Action.First())
+	specFields["principal"] = core.MappingNodeFromString(statement.principalValue())
+
+	organizationID := statement.conditionValue("StringEquals", "aws:PrincipalOrgID")
+	if organizationID != "" {
+		specFields["organizationId"] = core.MappingNodeFromString(organizationID)
+	}
+}
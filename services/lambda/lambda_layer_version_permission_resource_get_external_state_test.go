@@ -0,0 +1,289 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaLayerVersionPermissionResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaLayerVersionPermissionResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createAccountLayerVersionPermissionStateTestCase(providerCtx, loader),
+		createOrganizationLayerVersionPermissionStateTestCase(providerCtx, loader),
+		createRevokedLayerVersionPermissionStateTestCase(providerCtx, loader),
+		createLayerVersionPermissionNoPolicyStateTestCase(providerCtx, loader),
+		createGetLayerVersionPolicyErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		LayerVersionPermissionResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaLayerVersionPermissionResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaLayerVersionPermissionResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+const testLayerVersionARN = "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3"
+
+func createAccountLayerVersionPermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "celerity-test-layer-permission-id",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::210987654321:root"},
+				"Action": "lambda:GetLayerVersion",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3"
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets layer version permission state for an account",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionPolicyOutput(&lambda.GetLayerVersionPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"action":          core.MappingNodeFromString("lambda:GetLayerVersion"),
+					"principal":       core.MappingNodeFromString("210987654321"),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"action":          core.MappingNodeFromString("lambda:GetLayerVersion"),
+					"principal":       core.MappingNodeFromString("210987654321"),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createOrganizationLayerVersionPermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "share-with-org",
+				"Effect": "Allow",
+				"Principal": "*",
+				"Action": "lambda:GetLayerVersion",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3",
+				"Condition": {
+					"StringEquals": {"aws:PrincipalOrgID": "o-a1b2c3d4e5"}
+				}
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets layer version permission state for an organization",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionPolicyOutput(&lambda.GetLayerVersionPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"statementId":     core.MappingNodeFromString("share-with-org"),
+					"id":              core.MappingNodeFromString("share-with-org"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"action":          core.MappingNodeFromString("lambda:GetLayerVersion"),
+					"principal":       core.MappingNodeFromString("*"),
+					"organizationId":  core.MappingNodeFromString("o-a1b2c3d4e5"),
+					"statementId":     core.MappingNodeFromString("share-with-org"),
+					"id":              core.MappingNodeFromString("share-with-org"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createRevokedLayerVersionPermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "other-statement",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+				"Action": "lambda:GetLayerVersion",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:3"
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "reports layer version permission without statement fields when statement has been revoked",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionPolicyOutput(&lambda.GetLayerVersionPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"action":          core.MappingNodeFromString("lambda:GetLayerVersion"),
+					"principal":       core.MappingNodeFromString("210987654321"),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createLayerVersionPermissionNoPolicyStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "reports layer version permission without statement fields when layer version has no policy",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionPolicyError(&types.ResourceNotFoundException{
+				Message: aws.String("No policy is associated with the given resource."),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"action":          core.MappingNodeFromString("lambda:GetLayerVersion"),
+					"principal":       core.MappingNodeFromString("210987654321"),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetLayerVersionPolicyErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get layer version policy error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionPolicyError(errors.New("failed to get layer version policy")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerVersionArn": core.MappingNodeFromString(testLayerVersionARN),
+					"id":              core.MappingNodeFromString("celerity-test-layer-permission-id"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,83 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+// Layer version permission statements can not be modified in place, every field
+// of a permission requires the statement to be removed and added again
+// in order to apply changes.
+func lambdaLayerVersionPermissionResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaLayerVersionPermissionDefinition",
+		Description: "The definition of a permission statement in the resource-based policy of an AWS Lambda layer version.",
+		Required:    []string{"layerVersionArn", "action", "principal"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"layerVersionArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the layer version to grant permission to use.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:layer:my-shared-libraries:1"),
+				},
+				Pattern:      "^arn:[a-zA-Z0-9-]+:lambda:[a-zA-Z0-9-]+:\\d{12}:layer:[a-zA-Z0-9-_]+:[0-9]+$",
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"action": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The API action that grants access to the layer.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("lambda:GetLayerVersion"),
+				},
+				Pattern:      "^lambda:GetLayerVersion$",
+				MaxLength:    22,
+				MustRecreate: true,
+			},
+			"principal": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "An account ID, or * to grant layer usage permission to all accounts in an organization, " +
+					"or all AWS accounts (if organizationId is not specified).",
+				FormattedDescription: "An account ID, or `*` to grant layer usage permission to all accounts in an organization, " +
+					"or all AWS accounts (if `organizationId` is not specified). " +
+					"When `organizationId` is provided, the principal must be set to `*`.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("123456789012"),
+					core.MappingNodeFromString("*"),
+				},
+				// Covers the account ID, wildcard and IAM ARN forms of the principal
+				// that are accepted by the Lambda API.
+				Pattern:      "^(\\d{12}|\\*|arn:(aws[a-zA-Z-]*):iam::\\d{12}:root)$",
+				MustRecreate: true,
+			},
+			"organizationId": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "With the principal set to *, grant permission to all accounts in the " +
+					"specified organization.",
+				FormattedDescription: "With the principal set to `*`, grant permission to all accounts in the " +
+					"specified organization.",
+				Pattern:      "^o-[a-z0-9]{10,32}$",
+				MaxLength:    34,
+				MustRecreate: true,
+			},
+			"statementId": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "An identifier that distinguishes the policy from others on the same layer version. " +
+					"When not provided, a statement identifier is derived from the ID of the resource in the blueprint instance.",
+				Pattern:      "^([a-zA-Z0-9-_]+)$",
+				MinLength:    1,
+				MaxLength:    100,
+				MustRecreate: true,
+			},
+
+			// Computed fields
+			"id": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The statement identifier (Sid) of the permission in the layer version's resource-based policy.",
+				Computed:    true,
+			},
+		},
+	}
+}
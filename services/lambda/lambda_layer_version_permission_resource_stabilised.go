@@ -0,0 +1,18 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaLayerVersionPermissionResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// Permissions are applied to the layer version's resource-based policy
+	// as soon as they have been added, so they are always considered stable.
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: true,
+	}, nil
+}
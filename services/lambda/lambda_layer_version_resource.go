@@ -0,0 +1,65 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// LayerVersionResource returns a resource implementation for an AWS Lambda Layer Version.
+func LayerVersionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_layer_version_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_layer_version_jsonc.md")
+
+	lambdaLayerVersionActions := &lambdaLayerVersionResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/layerVersion",
+		Label:            "AWS Lambda Layer Version",
+		PlainTextSummary: "A resource for publishing a version of an AWS Lambda layer.",
+		FormattedDescription: "The resource type used to define a version of a [Lambda layer](https://docs.aws.amazon.com/lambda/latest/dg/chapter-layers.html) " +
+			"that packages libraries, a custom runtime or other dependencies that can be shared between Lambda functions. " +
+			"Layer versions are immutable, any change to a layer version will result in a new version being published.",
+		Schema:  lambdaLayerVersionResourceSchema(),
+		IDField: "layerVersionArn",
+		// A layer version is used by functions that include the layer,
+		// it is not usually a terminal resource.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaLayerVersionActions.GetExternalState,
+		CreateFunc:           lambdaLayerVersionActions.Create,
+		UpdateFunc:           lambdaLayerVersionActions.Update,
+		DestroyFunc:          lambdaLayerVersionActions.Destroy,
+		StabilisedFunc:       lambdaLayerVersionActions.Stabilised,
+	}
+}
+
+type lambdaLayerVersionResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaLayerVersionResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
@@ -0,0 +1,179 @@
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaLayerVersionResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&layerVersionPublish{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during layer version creation")
+	}
+
+	publishOutput, ok := saveOpCtx.Data["publishLayerVersionOutput"].(*lambda.PublishLayerVersionOutput)
+	if !ok {
+		return nil, fmt.Errorf("publishLayerVersionOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: layerVersionComputedFields(
+			aws.ToString(publishOutput.LayerVersionArn),
+			aws.ToString(publishOutput.LayerArn),
+			publishOutput.Version,
+		),
+	}, nil
+}
+
+func layerVersionComputedFields(
+	layerVersionARN string,
+	layerARN string,
+	version int64,
+) map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"spec.layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+		"spec.layerArn":        core.MappingNodeFromString(layerARN),
+		"spec.version":         core.MappingNodeFromInt(int(version)),
+	}
+}
+
+func changesToPublishLayerVersionInput(
+	specData *core.MappingNode,
+) (*lambda.PublishLayerVersionInput, bool, error) {
+	input := &lambda.PublishLayerVersionInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.PublishLayerVersionInput]{
+		pluginutils.NewValueSetter(
+			"$.layerName",
+			func(value *core.MappingNode, input *lambda.PublishLayerVersionInput) {
+				input.LayerName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.description",
+			func(value *core.MappingNode, input *lambda.PublishLayerVersionInput) {
+				input.Description = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.compatibleRuntimes",
+			func(value *core.MappingNode, input *lambda.PublishLayerVersionInput) {
+				for _, runtime := range core.StringSliceValue(value) {
+					input.CompatibleRuntimes = append(input.CompatibleRuntimes, types.Runtime(runtime))
+				}
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.compatibleArchitectures",
+			func(value *core.MappingNode, input *lambda.PublishLayerVersionInput) {
+				for _, architecture := range core.StringSliceValue(value) {
+					input.CompatibleArchitectures = append(
+						input.CompatibleArchitectures,
+						types.Architecture(architecture),
+					)
+				}
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.licenseInfo",
+			func(value *core.MappingNode, input *lambda.PublishLayerVersionInput) {
+				input.LicenseInfo = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	content, hasContent := pluginutils.GetValueByPath("$.content", specData)
+	if hasContent {
+		contentInput, err := layerVersionContentFromSpec(content)
+		if err != nil {
+			return nil, false, err
+		}
+		input.Content = contentInput
+		hasValues = true
+	}
+
+	return input, hasValues, nil
+}
+
+func layerVersionContentFromSpec(
+	content *core.MappingNode,
+) (*types.LayerVersionContentInput, error) {
+	contentInput := &types.LayerVersionContentInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*types.LayerVersionContentInput]{
+		pluginutils.NewValueSetter(
+			"$.s3Bucket",
+			func(value *core.MappingNode, target *types.LayerVersionContentInput) {
+				target.S3Bucket = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.s3Key",
+			func(value *core.MappingNode, target *types.LayerVersionContentInput) {
+				target.S3Key = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.s3ObjectVersion",
+			func(value *core.MappingNode, target *types.LayerVersionContentInput) {
+				target.S3ObjectVersion = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(content, contentInput)
+	}
+
+	// The inline archive is provided as a base64-encoded string in the spec,
+	// it must be decoded as the SDK will encode the raw bytes of the archive
+	// when the request is sent.
+	zipFile, hasZipFile := pluginutils.GetValueByPath("$.zipFile", content)
+	if hasZipFile {
+		zipBytes, err := base64.StdEncoding.DecodeString(core.StringValue(zipFile))
+		if err != nil {
+			return nil, fmt.Errorf("layer content zipFile must be a base64-encoded .zip file archive: %w", err)
+		}
+		contentInput.ZipFile = zipBytes
+	}
+
+	return contentInput, nil
+}
@@ -0,0 +1,52 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type layerVersionPublish struct {
+	input *lambda.PublishLayerVersionInput
+}
+
+func (u *layerVersionPublish) Name() string {
+	return "publish layer version"
+}
+
+func (u *layerVersionPublish) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues, err := changesToPublishLayerVersionInput(specData)
+	if err != nil {
+		return false, saveOpCtx, err
+	}
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *layerVersionPublish) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	publishOutput, err := lambdaService.PublishLayerVersion(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(publishOutput.LayerVersionArn)
+	newSaveOpCtx.Data["publishLayerVersionOutput"] = publishOutput
+
+	return newSaveOpCtx, nil
+}
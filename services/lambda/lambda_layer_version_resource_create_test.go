@@ -0,0 +1,286 @@
+package lambda
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaLayerVersionResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaLayerVersionResourceCreateSuite) Test_create_lambda_layer_version() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createLayerVersionFromS3TestCase(providerCtx, loader),
+		createLayerVersionWithInlineZipFileTestCase(providerCtx, loader),
+		createLayerVersionInvalidZipFileTestCase(providerCtx, loader),
+		createLayerVersionFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		LayerVersionResource,
+		&s.Suite,
+	)
+}
+
+func createLayerVersionFromS3TestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	layerARN := "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries"
+	layerVersionARN := layerARN + ":3"
+
+	service := createLambdaServiceMock(
+		WithPublishLayerVersionOutput(&lambda.PublishLayerVersionOutput{
+			LayerArn:        aws.String(layerARN),
+			LayerVersionArn: aws.String(layerVersionARN),
+			Version:         3,
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerName":   core.MappingNodeFromString("shared-libraries"),
+			"description": core.MappingNodeFromString("Shared libraries"),
+			"content": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket":        core.MappingNodeFromString("my-layer-artifacts"),
+					"s3Key":           core.MappingNodeFromString("layers/shared-libraries.zip"),
+					"s3ObjectVersion": core.MappingNodeFromString("v1"),
+				},
+			},
+			"compatibleRuntimes":      core.MappingNodeFromStringSlice([]string{"python3.12", "python3.13"}),
+			"compatibleArchitectures": core.MappingNodeFromStringSlice([]string{"x86_64", "arm64"}),
+			"licenseInfo":             core.MappingNodeFromString("MIT"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version from S3 object",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createLayerVersionDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+				"spec.layerArn":        core.MappingNodeFromString(layerARN),
+				"spec.version":         core.MappingNodeFromInt(3),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishLayerVersion": &lambda.PublishLayerVersionInput{
+				LayerName:   aws.String("shared-libraries"),
+				Description: aws.String("Shared libraries"),
+				Content: &types.LayerVersionContentInput{
+					S3Bucket:        aws.String("my-layer-artifacts"),
+					S3Key:           aws.String("layers/shared-libraries.zip"),
+					S3ObjectVersion: aws.String("v1"),
+				},
+				CompatibleRuntimes: []types.Runtime{
+					types.RuntimePython312,
+					types.RuntimePython313,
+				},
+				CompatibleArchitectures: []types.Architecture{
+					types.ArchitectureX8664,
+					types.ArchitectureArm64,
+				},
+				LicenseInfo: aws.String("MIT"),
+			},
+		},
+	}
+}
+
+func createLayerVersionWithInlineZipFileTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	layerARN := "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries"
+	layerVersionARN := layerARN + ":1"
+	zipContents := []byte("PK\x03\x04test-layer-archive")
+
+	service := createLambdaServiceMock(
+		WithPublishLayerVersionOutput(&lambda.PublishLayerVersionOutput{
+			LayerArn:        aws.String(layerARN),
+			LayerVersionArn: aws.String(layerVersionARN),
+			Version:         1,
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerName": core.MappingNodeFromString("shared-libraries"),
+			"content": {
+				Fields: map[string]*core.MappingNode{
+					"zipFile": core.MappingNodeFromString(
+						base64.StdEncoding.EncodeToString(zipContents),
+					),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version with inline zip file",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createLayerVersionDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+				"spec.layerArn":        core.MappingNodeFromString(layerARN),
+				"spec.version":         core.MappingNodeFromInt(1),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishLayerVersion": &lambda.PublishLayerVersionInput{
+				LayerName: aws.String("shared-libraries"),
+				Content: &types.LayerVersionContentInput{
+					ZipFile: zipContents,
+				},
+			},
+		},
+	}
+}
+
+func createLayerVersionInvalidZipFileTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock()
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerName": core.MappingNodeFromString("shared-libraries"),
+			"content": {
+				Fields: map[string]*core.MappingNode{
+					"zipFile": core.MappingNodeFromString("not valid base64!"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version fails for zip file that is not base64-encoded",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createLayerVersionDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createLayerVersionFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPublishLayerVersionError(errors.New("failed to publish layer version")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerName": core.MappingNodeFromString("shared-libraries"),
+			"content": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("my-layer-artifacts"),
+					"s3Key":    core.MappingNodeFromString("layers/shared-libraries.zip"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create layer version failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createLayerVersionDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createLayerVersionDeployInput(
+	specData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	newFields := []provider.FieldChange{}
+	for fieldName := range specData.Fields {
+		newFields = append(newFields, provider.FieldChange{
+			FieldPath: "spec." + fieldName,
+		})
+	}
+
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-layer-version-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-layer-version-id",
+				ResourceName: "TestLayerVersion",
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/layerVersion",
+					},
+					Spec: specData,
+				},
+			},
+			NewFields: newFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaLayerVersionResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaLayerVersionResourceCreateSuite))
+}
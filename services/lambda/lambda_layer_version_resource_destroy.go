@@ -0,0 +1,39 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaLayerVersionResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	// Functions that already use the layer version keep a copy of the
+	// layer content, deleting the version only prevents it from being
+	// added to new functions.
+	layerName := core.StringValue(
+		input.ResourceState.SpecData.Fields["layerName"],
+	)
+	version := core.IntValue(
+		input.ResourceState.SpecData.Fields["version"],
+	)
+	_, err = lambdaService.DeleteLayerVersion(
+		ctx,
+		&lambda.DeleteLayerVersionInput{
+			LayerName:     &layerName,
+			VersionNumber: aws.Int64(int64(version)),
+		},
+	)
+
+	return err
+}
@@ -0,0 +1,124 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaLayerVersionResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	layerName := core.StringValue(
+		input.CurrentResourceSpec.Fields["layerName"],
+	)
+	version := core.IntValue(
+		input.CurrentResourceSpec.Fields["version"],
+	)
+
+	layerVersionOutput, err := lambdaService.GetLayerVersion(
+		ctx,
+		&lambda.GetLayerVersionInput{
+			LayerName:     &layerName,
+			VersionNumber: aws.Int64(int64(version)),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(
+		layerVersionOutput,
+		input.CurrentResourceSpec,
+	)
+
+	l.addOptionalConfigurationsToSpec(layerVersionOutput, resourceSpecState.Fields)
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaLayerVersionResourceActions) buildBaseResourceSpecState(
+	layerVersionOutput *lambda.GetLayerVersionOutput,
+	currentResourceSpec *core.MappingNode,
+) *core.MappingNode {
+	// The layer name and content are taken from the current spec
+	// as AWS only provides a pre-signed URL for downloading the layer
+	// archive and the layer name could have been provided as an ARN.
+	// Both fields can not change without publishing a new layer version.
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerName": currentResourceSpec.Fields["layerName"],
+			"content":   currentResourceSpec.Fields["content"],
+			"layerVersionArn": core.MappingNodeFromString(
+				aws.ToString(layerVersionOutput.LayerVersionArn),
+			),
+			"layerArn": core.MappingNodeFromString(
+				aws.ToString(layerVersionOutput.LayerArn),
+			),
+			"version": core.MappingNodeFromInt(
+				int(layerVersionOutput.Version),
+			),
+		},
+	}
+}
+
+func (l *lambdaLayerVersionResourceActions) addOptionalConfigurationsToSpec(
+	layerVersionOutput *lambda.GetLayerVersionOutput,
+	specFields map[string]*core.MappingNode,
+) {
+	configurations := []optionalConfiguration{
+		{
+			condition: func() bool { return aws.ToString(layerVersionOutput.Description) != "" },
+			field:     "description",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromString(aws.ToString(layerVersionOutput.Description))
+			},
+		},
+		{
+			condition: func() bool { return len(layerVersionOutput.CompatibleRuntimes) > 0 },
+			field:     "compatibleRuntimes",
+			value: func() *core.MappingNode {
+				runtimes := make([]string, len(layerVersionOutput.CompatibleRuntimes))
+				for i, runtime := range layerVersionOutput.CompatibleRuntimes {
+					runtimes[i] = string(runtime)
+				}
+				return core.MappingNodeFromStringSlice(runtimes)
+			},
+		},
+		{
+			condition: func() bool { return len(layerVersionOutput.CompatibleArchitectures) > 0 },
+			field:     "compatibleArchitectures",
+			value: func() *core.MappingNode {
+				architectures := make([]string, len(layerVersionOutput.CompatibleArchitectures))
+				for i, architecture := range layerVersionOutput.CompatibleArchitectures {
+					architectures[i] = string(architecture)
+				}
+				return core.MappingNodeFromStringSlice(architectures)
+			},
+		},
+		{
+			condition: func() bool { return aws.ToString(layerVersionOutput.LicenseInfo) != "" },
+			field:     "licenseInfo",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromString(aws.ToString(layerVersionOutput.LicenseInfo))
+			},
+		},
+	}
+
+	for _, config := range configurations {
+		if config.condition() {
+			specFields[config.field] = config.value()
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaLayerVersionResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaLayerVersionResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createBasicLayerVersionStateTestCase(providerCtx, loader),
+		createLayerVersionWithAllConfigsStateTestCase(providerCtx, loader),
+		createGetLayerVersionErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		LayerVersionResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaLayerVersionResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaLayerVersionResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createBasicLayerVersionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	layerARN := "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries"
+	layerVersionARN := layerARN + ":1"
+	content := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"s3Bucket": core.MappingNodeFromString("my-layer-artifacts"),
+			"s3Key":    core.MappingNodeFromString("layers/shared-libraries.zip"),
+		},
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets basic layer version state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionOutput(&lambda.GetLayerVersionOutput{
+				LayerArn:        aws.String(layerARN),
+				LayerVersionArn: aws.String(layerVersionARN),
+				Version:         1,
+				Content: &types.LayerVersionContentOutput{
+					Location: aws.String("https://awslambda-us-west-2-layers.s3.us-west-2.amazonaws.com/snapshots/shared-libraries"),
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerName":       core.MappingNodeFromString("shared-libraries"),
+					"content":         content,
+					"layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+					"version":         core.MappingNodeFromInt(1),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerName":       core.MappingNodeFromString("shared-libraries"),
+					"content":         content,
+					"layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+					"layerArn":        core.MappingNodeFromString(layerARN),
+					"version":         core.MappingNodeFromInt(1),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createLayerVersionWithAllConfigsStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	layerARN := "arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries"
+	layerVersionARN := layerARN + ":3"
+	content := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"s3Bucket": core.MappingNodeFromString("my-layer-artifacts"),
+			"s3Key":    core.MappingNodeFromString("layers/shared-libraries.zip"),
+		},
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets layer version state with all configurations",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionOutput(&lambda.GetLayerVersionOutput{
+				LayerArn:        aws.String(layerARN),
+				LayerVersionArn: aws.String(layerVersionARN),
+				Version:         3,
+				Description:     aws.String("Shared libraries"),
+				CompatibleRuntimes: []types.Runtime{
+					types.RuntimePython312,
+					types.RuntimePython313,
+				},
+				CompatibleArchitectures: []types.Architecture{
+					types.ArchitectureArm64,
+				},
+				LicenseInfo: aws.String("MIT"),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerName":       core.MappingNodeFromString(layerARN),
+					"content":         content,
+					"layerVersionArn": core.MappingNodeFromString(layerVersionARN),
+					"version":         core.MappingNodeFromInt(3),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerName":               core.MappingNodeFromString(layerARN),
+					"content":                 content,
+					"layerVersionArn":         core.MappingNodeFromString(layerVersionARN),
+					"layerArn":                core.MappingNodeFromString(layerARN),
+					"version":                 core.MappingNodeFromInt(3),
+					"description":             core.MappingNodeFromString("Shared libraries"),
+					"compatibleRuntimes":      core.MappingNodeFromStringSlice([]string{"python3.12", "python3.13"}),
+					"compatibleArchitectures": core.MappingNodeFromStringSlice([]string{"arm64"}),
+					"licenseInfo":             core.MappingNodeFromString("MIT"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetLayerVersionErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get layer version error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetLayerVersionError(errors.New("failed to get layer version")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerName":       core.MappingNodeFromString("shared-libraries"),
+					"layerVersionArn": core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:layer:shared-libraries:1"),
+					"version":         core.MappingNodeFromInt(1),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
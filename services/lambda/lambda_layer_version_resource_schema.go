@@ -0,0 +1,132 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaLayerVersionResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaLayerVersionDefinition",
+		Description: "The definition of a version of an AWS Lambda layer.",
+		Required:    []string{"layerName", "content"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"layerName": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or Amazon Resource Name (ARN) of the layer.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("my-shared-libraries"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:layer:my-shared-libraries"),
+				},
+				Pattern:      "^(arn:[a-zA-Z0-9-]+:lambda:[a-zA-Z0-9-]+:\\d{12}:layer:[a-zA-Z0-9-_]+|[a-zA-Z0-9-_]+)$",
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"description": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "The description of the layer version.",
+				MaxLength:    256,
+				MustRecreate: true,
+			},
+			"content": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "LayerVersionContent",
+				Description: "The content of the layer version. You can either specify an object in Amazon S3 " +
+					"or provide a base64-encoded .zip file archive inline.",
+				FormattedDescription: "The content of the layer version. You can either specify an object in Amazon S3 " +
+					"or provide a base64-encoded .zip file archive inline. The archive must follow the " +
+					"[layer path conventions](https://docs.aws.amazon.com/lambda/latest/dg/packaging-layers.html#packaging-layers-paths) " +
+					"for the runtimes that use the layer.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"s3Bucket": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The Amazon S3 bucket of the layer archive.",
+						// We can't do a negative lookbehind with Go's regexp engine, the regexp
+						// for the bucket name in the official AWS API documentation for Lambda
+						// includes a negative lookbehind to ensure that a bucket name that consists
+						// of only a single period (".") is not allowed.
+						// Due to this, bucket names that start with "." will fail validation
+						// with the provider.
+						Pattern:   "^[0-9A-Za-z\\-_][0-9A-Za-z\\.\\-_]+$",
+						MinLength: 3,
+						MaxLength: 63,
+					},
+					"s3Key": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The Amazon S3 key of the layer archive.",
+						MinLength:   1,
+						MaxLength:   1024,
+					},
+					"s3ObjectVersion": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "For versioned objects, the version of the layer archive object to use.",
+						MinLength:   1,
+						MaxLength:   1024,
+					},
+					"zipFile": {
+						Type: provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The base64-encoded contents of the layer archive. " +
+							"The decoded archive cannot exceed 50MB.",
+					},
+				},
+				MustRecreate: true,
+			},
+			"compatibleRuntimes": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "A list of function runtimes compatible with the layer version, up to 15 runtimes can be specified.",
+				FormattedDescription: "A list of function [runtimes](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html) " +
+					"compatible with the layer version, up to 15 runtimes can be specified.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:          provider.ResourceDefinitionsSchemaTypeString,
+					AllowedValues: lambdaRuntimeAllowedValues(),
+				},
+				MaxLength:    15,
+				MustRecreate: true,
+			},
+			"compatibleArchitectures": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "A list of instruction set architectures compatible with the layer version.",
+				FormattedDescription: "A list of [instruction set architectures](https://docs.aws.amazon.com/lambda/latest/dg/foundation-arch.html) " +
+					"compatible with the layer version.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+					AllowedValues: []*core.MappingNode{
+						core.MappingNodeFromString("x86_64"),
+						core.MappingNodeFromString("arm64"),
+					},
+				},
+				MaxLength:    2,
+				MustRecreate: true,
+			},
+			"licenseInfo": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The layer's software license. This can be an SPDX license identifier, " +
+					"the URL of a license hosted on the internet or the full text of the license.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MIT"),
+				},
+				MaxLength:    512,
+				MustRecreate: true,
+			},
+
+			// Computed fields
+			"layerVersionArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the layer version, used to add the layer to a function.",
+				Computed:    true,
+			},
+			"layerArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the layer.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The version number of the layer version.",
+				Computed:    true,
+			},
+		},
+	}
+}
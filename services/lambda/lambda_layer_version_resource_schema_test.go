@@ -0,0 +1,40 @@
+package lambda
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaLayerVersionResourceSchemaSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaLayerVersionResourceSchemaSuite) Test_layer_name_pattern() {
+	pattern := regexp.MustCompile(
+		lambdaLayerVersionResourceSchema().Attributes["layerName"].Pattern,
+	)
+
+	validNames := []string{
+		"my-shared-libraries",
+		"my_shared_libraries",
+		"arn:aws:lambda:us-west-2:123456789012:layer:my-shared-libraries",
+	}
+	for _, name := range validNames {
+		s.Assert().True(pattern.MatchString(name), name)
+	}
+
+	invalidNames := []string{
+		"!!my-shared-libraries",
+		"my shared libraries",
+		"arn:aws:lambda:us-west-2:123456789012:layer:my-shared-libraries:1!",
+	}
+	for _, name := range invalidNames {
+		s.Assert().False(pattern.MatchString(name), name)
+	}
+}
+
+func TestLambdaLayerVersionResourceSchemaSuite(t *testing.T) {
+	suite.Run(t, new(LambdaLayerVersionResourceSchemaSuite))
+}
@@ -0,0 +1,35 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaLayerVersionResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	// Layer versions are immutable, all fields of a layer version
+	// require a new version to be published when they change,
+	// so there is nothing to update and the current computed fields are returned.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	computedFields := map[string]*core.MappingNode{}
+	for _, field := range []string{"layerVersionArn", "layerArn", "version"} {
+		value, err := core.GetPathValue(
+			"$."+field,
+			currentStateSpecData,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if err != nil {
+			return nil, err
+		}
+		computedFields["spec."+field] = value
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: computedFields,
+	}, nil
+}
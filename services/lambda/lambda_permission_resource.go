@@ -0,0 +1,66 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// PermissionResource returns a resource implementation for a statement
+// in the resource-based policy of an AWS Lambda Function.
+func PermissionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_permission_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_permission_jsonc.md")
+
+	lambdaPermissionActions := &lambdaPermissionResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/permission",
+		Label:            "AWS Lambda Permission",
+		PlainTextSummary: "A resource for granting an AWS service or another account permission to use an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define a statement in the [resource-based policy](https://docs.aws.amazon.com/lambda/latest/dg/access-control-resource-based.html) " +
+			"of a Lambda function. This is used to grant AWS services such as Amazon S3, Amazon SNS, API Gateway or Amazon EventBridge, " +
+			"or other AWS accounts permission to invoke a function.",
+		Schema:  lambdaPermissionResourceSchema(),
+		IDField: "id",
+		// A permission grants access to a function for another resource,
+		// it is a terminal resource that other resources will not usually depend on.
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaPermissionActions.GetExternalState,
+		CreateFunc:           lambdaPermissionActions.Create,
+		UpdateFunc:           lambdaPermissionActions.Update,
+		DestroyFunc:          lambdaPermissionActions.Destroy,
+		StabilisedFunc:       lambdaPermissionActions.Stabilised,
+	}
+}
+
+type lambdaPermissionResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaPermissionResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
@@ -0,0 +1,139 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaPermissionResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&permissionAdd{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{
+				"defaultStatementId": defaultPermissionStatementID(input.ResourceID),
+			},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during permission creation")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(saveOpCtx.ProviderUpstreamID),
+		},
+	}, nil
+}
+
+// The ID of a resource in a blueprint instance is unique and stable
+// for the lifetime of the resource, making it a suitable identifier for
+// a statement when one is not provided.
+func defaultPermissionStatementID(resourceID string) string {
+	return fmt.Sprintf("celerity-%s", resourceID)
+}
+
+func changesToAddPermissionInput(
+	specData *core.MappingNode,
+	defaultStatementID string,
+) (*lambda.AddPermissionInput, bool) {
+	input := &lambda.AddPermissionInput{
+		StatementId: aws.String(defaultStatementID),
+	}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.AddPermissionInput]{
+		pluginutils.NewValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.action",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.Action = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.principal",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.Principal = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.statementId",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.StatementId = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.qualifier",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.Qualifier = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.sourceArn",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.SourceArn = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.sourceAccount",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.SourceAccount = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.principalOrgID",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.PrincipalOrgID = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.functionUrlAuthType",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.FunctionUrlAuthType = types.FunctionUrlAuthType(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.eventSourceToken",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.EventSourceToken = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
@@ -0,0 +1,50 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type permissionAdd struct {
+	input *lambda.AddPermissionInput
+}
+
+func (u *permissionAdd) Name() string {
+	return "add permission"
+}
+
+func (u *permissionAdd) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	defaultStatementID, _ := saveOpCtx.Data["defaultStatementId"].(string)
+	input, hasValues := changesToAddPermissionInput(specData, defaultStatementID)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *permissionAdd) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	addPermissionOutput, err := lambdaService.AddPermission(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(u.input.StatementId)
+	newSaveOpCtx.Data["addPermissionOutput"] = addPermissionOutput
+
+	return newSaveOpCtx, nil
+}
@@ -0,0 +1,283 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceCreateSuite) Test_create_lambda_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createServicePermissionTestCase(providerCtx, loader),
+		createFunctionURLPermissionTestCase(providerCtx, loader),
+		createPermissionFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func createServicePermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithAddPermissionOutput(&lambda.AddPermissionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":  core.MappingNodeFromString("test-function"),
+			"action":        core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":     core.MappingNodeFromString("s3.amazonaws.com"),
+			"sourceArn":     core.MappingNodeFromString("arn:aws:s3:::test-bucket"),
+			"sourceAccount": core.MappingNodeFromString("123456789012"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create permission for an AWS service with a generated statement ID",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.action",
+					},
+					{
+						FieldPath: "spec.principal",
+					},
+					{
+						FieldPath: "spec.sourceArn",
+					},
+					{
+						FieldPath: "spec.sourceAccount",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("celerity-test-permission-id"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"AddPermission": &lambda.AddPermissionInput{
+				FunctionName:  aws.String("test-function"),
+				StatementId:   aws.String("celerity-test-permission-id"),
+				Action:        aws.String("lambda:InvokeFunction"),
+				Principal:     aws.String("s3.amazonaws.com"),
+				SourceArn:     aws.String("arn:aws:s3:::test-bucket"),
+				SourceAccount: aws.String("123456789012"),
+			},
+		},
+	}
+}
+
+func createFunctionURLPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithAddPermissionOutput(&lambda.AddPermissionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":        core.MappingNodeFromString("test-function"),
+			"action":              core.MappingNodeFromString("lambda:InvokeFunctionUrl"),
+			"principal":           core.MappingNodeFromString("*"),
+			"statementId":         core.MappingNodeFromString("AllowPublicFunctionUrl"),
+			"qualifier":           core.MappingNodeFromString("live"),
+			"functionUrlAuthType": core.MappingNodeFromString("NONE"),
+			"principalOrgID":      core.MappingNodeFromString("o-a1b2c3d4e5"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create permission for a function URL with a custom statement ID",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.action",
+					},
+					{
+						FieldPath: "spec.principal",
+					},
+					{
+						FieldPath: "spec.statementId",
+					},
+					{
+						FieldPath: "spec.qualifier",
+					},
+					{
+						FieldPath: "spec.functionUrlAuthType",
+					},
+					{
+						FieldPath: "spec.principalOrgID",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("AllowPublicFunctionUrl"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"AddPermission": &lambda.AddPermissionInput{
+				FunctionName:        aws.String("test-function"),
+				StatementId:         aws.String("AllowPublicFunctionUrl"),
+				Action:              aws.String("lambda:InvokeFunctionUrl"),
+				Principal:           aws.String("*"),
+				Qualifier:           aws.String("live"),
+				FunctionUrlAuthType: types.FunctionUrlAuthTypeNone,
+				PrincipalOrgID:      aws.String("o-a1b2c3d4e5"),
+			},
+		},
+	}
+}
+
+func createPermissionFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithAddPermissionError(errors.New("failed to add permission")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("sns.amazonaws.com"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create permission failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.action",
+					},
+					{
+						FieldPath: "spec.principal",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaPermissionResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceCreateSuite))
+}
@@ -0,0 +1,142 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaPermissionResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.CurrentResourceSpec.Fields["functionName"],
+	)
+	statementID := core.StringValue(
+		input.CurrentResourceSpec.Fields["id"],
+	)
+
+	getPolicyInput := &lambda.GetPolicyInput{
+		FunctionName: &functionName,
+	}
+	qualifier, hasQualifier := pluginutils.GetValueByPath(
+		"$.qualifier",
+		input.CurrentResourceSpec,
+	)
+	if hasQualifier {
+		getPolicyInput.Qualifier = aws.String(core.StringValue(qualifier))
+	}
+
+	policy, err := l.getFunctionPolicy(ctx, getPolicyInput, lambdaService)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get resource-based policy for function %q: %w",
+			functionName,
+			err,
+		)
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(
+		functionName,
+		statementID,
+		input.CurrentResourceSpec,
+	)
+
+	// A statement that has been removed from the policy outside of
+	// the blueprint is reported without the permission fields so the
+	// revoked permission is surfaced as drift.
+	statement := policy.findStatement(statementID)
+	if statement != nil {
+		l.addStatementToSpec(statement, resourceSpecState.Fields)
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+// A function without any permissions does not have a policy,
+// this is treated as an empty policy document.
+func (l *lambdaPermissionResourceActions) getFunctionPolicy(
+	ctx context.Context,
+	getPolicyInput *lambda.GetPolicyInput,
+	lambdaService Service,
+) (*policyDocument, error) {
+	policyOutput, err := lambdaService.GetPolicy(ctx, getPolicyInput)
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return &policyDocument{}, nil
+		}
+		return nil, err
+	}
+
+	return parsePolicyDocument(aws.ToString(policyOutput.Policy))
+}
+
+func (l *lambdaPermissionResourceActions) buildBaseResourceSpecState(
+	functionName string,
+	statementID string,
+	currentResourceSpec *core.MappingNode,
+) *core.MappingNode {
+	fields := map[string]*core.MappingNode{
+		"functionName": core.MappingNodeFromString(functionName),
+		"id":           core.MappingNodeFromString(statementID),
+	}
+
+	// The statement ID and qualifier are used to locate the statement,
+	// they are sourced from the current spec as the policy document does not
+	// contain a representation of them that can be mapped back to the spec.
+	if statementIDInSpec, hasStatementID := currentResourceSpec.Fields["statementId"]; hasStatementID {
+		fields["statementId"] = statementIDInSpec
+	}
+
+	if qualifier, hasQualifier := currentResourceSpec.Fields["qualifier"]; hasQualifier {
+		fields["qualifier"] = qualifier
+	}
+
+	return &core.MappingNode{
+		Fields: fields,
+	}
+}
+
+func (l *lambdaPermissionResourceActions) addStatementToSpec(
+	statement *policyStatement,
+	specFields map[string]*core.MappingNode,
+) {
+	specFields["action"] = core.MappingNodeFromString(statement.Action.First())
+	specFields["principal"] = core.MappingNodeFromString(statement.principalValue())
+
+	// Optional fields are applied as conditions in the policy statement.
+	configurations := []struct {
+		field     string
+		operator  string
+		condition string
+	}{
+		{field: "sourceArn", operator: "ArnLike", condition: "AWS:SourceArn"},
+		{field: "sourceAccount", operator: "StringEquals", condition: "AWS:SourceAccount"},
+		{field: "principalOrgID", operator: "StringEquals", condition: "aws:PrincipalOrgID"},
+		{field: "eventSourceToken", operator: "StringEquals", condition: "lambda:EventSourceToken"},
+		{field: "functionUrlAuthType", operator: "StringEquals", condition: "lambda:FunctionUrlAuthType"},
+	}
+
+	for _, config := range configurations {
+		value := statement.conditionValue(config.operator, config.condition)
+		if value != "" {
+			specFields[config.field] = core.MappingNodeFromString(value)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createServicePermissionStateTestCase(providerCtx, loader),
+		createAccountPermissionStateTestCase(providerCtx, loader),
+		createMissingStatementPermissionStateTestCase(providerCtx, loader),
+		createNoPolicyPermissionStateTestCase(providerCtx, loader),
+		createGetPolicyErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaPermissionResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createServicePermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "other-statement",
+				"Effect": "Allow",
+				"Principal": {"Service": "sns.amazonaws.com"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+			},
+			{
+				"Sid": "celerity-test-permission-id",
+				"Effect": "Allow",
+				"Principal": {"Service": "s3.amazonaws.com"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function",
+				"Condition": {
+					"StringEquals": {"AWS:SourceAccount": "123456789012"},
+					"ArnLike": {"AWS:SourceArn": "arn:aws:s3:::test-bucket"}
+				}
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets permission state for an AWS service principal",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":            core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName":  core.MappingNodeFromString("test-function"),
+					"action":        core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":     core.MappingNodeFromString("s3.amazonaws.com"),
+					"sourceArn":     core.MappingNodeFromString("arn:aws:s3:::test-bucket"),
+					"sourceAccount": core.MappingNodeFromString("123456789012"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":            core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName":  core.MappingNodeFromString("test-function"),
+					"action":        core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":     core.MappingNodeFromString("s3.amazonaws.com"),
+					"sourceArn":     core.MappingNodeFromString("arn:aws:s3:::test-bucket"),
+					"sourceAccount": core.MappingNodeFromString("123456789012"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createAccountPermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "AllowCrossAccount",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::210987654321:root"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function:live",
+				"Condition": {
+					"StringEquals": {"aws:PrincipalOrgID": "o-a1b2c3d4e5"}
+				}
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets permission state for an account principal",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":           core.MappingNodeFromString("AllowCrossAccount"),
+					"statementId":  core.MappingNodeFromString("AllowCrossAccount"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"qualifier":    core.MappingNodeFromString("live"),
+					"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":    core.MappingNodeFromString("210987654321"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":             core.MappingNodeFromString("AllowCrossAccount"),
+					"statementId":    core.MappingNodeFromString("AllowCrossAccount"),
+					"functionName":   core.MappingNodeFromString("test-function"),
+					"qualifier":      core.MappingNodeFromString("live"),
+					"action":         core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":      core.MappingNodeFromString("210987654321"),
+					"principalOrgID": core.MappingNodeFromString("o-a1b2c3d4e5"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createMissingStatementPermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "other-statement",
+				"Effect": "Allow",
+				"Principal": {"Service": "sns.amazonaws.com"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "reports permission without statement fields when the statement is missing from the policy",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":           core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":    core.MappingNodeFromString("s3.amazonaws.com"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":           core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName": core.MappingNodeFromString("test-function"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createNoPolicyPermissionStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "reports permission without statement fields when the function has no policy",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetPolicyError(&types.ResourceNotFoundException{
+				Message: aws.String("The resource you requested does not exist."),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":           core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":    core.MappingNodeFromString("s3.amazonaws.com"),
+					"qualifier":    core.MappingNodeFromString("live"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":           core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"qualifier":    core.MappingNodeFromString("live"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetPolicyErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get policy error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetPolicyError(errors.New("failed to get policy")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id":           core.MappingNodeFromString("celerity-test-permission-id"),
+					"functionName": core.MappingNodeFromString("test-function"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,127 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+// Permission statements can not be modified in place, every field
+// of a permission requires the statement to be removed and added again
+// in order to apply changes.
+func lambdaPermissionResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaPermissionDefinition",
+		Description: "The definition of a permission statement in the resource-based policy of an AWS Lambda function.",
+		Required:    []string{"functionName", "action", "principal"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function to grant permission to use. " +
+					"This can be the function name, the function ARN or a partial ARN.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"action": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The action that the principal can use on the function.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("lambda:InvokeFunction"),
+					core.MappingNodeFromString("lambda:GetFunction"),
+				},
+				Pattern:      "^(lambda:[*]|lambda:[a-zA-Z]+|[*])$",
+				MustRecreate: true,
+			},
+			"principal": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The AWS service, AWS account, IAM user or IAM role that invokes the function. " +
+					"If you specify a service, use sourceArn or sourceAccount to limit who can invoke the function through that service.",
+				FormattedDescription: "The AWS service, AWS account, IAM user or IAM role that invokes the function. " +
+					"If you specify a service, use `sourceArn` or `sourceAccount` to limit who can invoke the function through that service.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("s3.amazonaws.com"),
+					core.MappingNodeFromString("123456789012"),
+				},
+				Pattern:      "^[^\\s]+$",
+				MustRecreate: true,
+			},
+			"statementId": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "A statement identifier that differentiates the statement from others in the same policy. " +
+					"When not provided, a statement identifier is derived from the ID of the resource in the blueprint instance.",
+				Pattern:      "^([a-zA-Z0-9-_.]+)$",
+				MinLength:    1,
+				MaxLength:    100,
+				MustRecreate: true,
+			},
+			"qualifier": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "The version or alias of the function to add the permission to.",
+				Pattern:      "^(|[a-zA-Z0-9$_-]+)$",
+				MinLength:    1,
+				MaxLength:    128,
+				MustRecreate: true,
+			},
+			"sourceArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "For AWS services, the ARN of the AWS resource that invokes the function. " +
+					"For example, an Amazon S3 bucket or Amazon SNS topic.",
+				Pattern:      "^arn:(aws[a-zA-Z0-9-]*):([a-zA-Z0-9\\-])+:([a-z]{2}(-gov)?-[a-z]+-\\d{1})?:(\\d{12})?:(.*)$",
+				MustRecreate: true,
+			},
+			"sourceAccount": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "For AWS services, the ID of the AWS account that owns the resource. " +
+					"Use this together with sourceArn to ensure that the specified account owns the resource.",
+				FormattedDescription: "For AWS services, the ID of the AWS account that owns the resource. " +
+					"Use this together with `sourceArn` to ensure that the specified account owns the resource.",
+				Pattern:      "^\\d{12}$",
+				MustRecreate: true,
+			},
+			"principalOrgID": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The identifier for your organization in AWS Organizations. " +
+					"Use this to grant permissions to all the AWS accounts under this organization.",
+				Pattern:      "^o-[a-z0-9]{10,32}$",
+				MinLength:    12,
+				MaxLength:    34,
+				MustRecreate: true,
+			},
+			"functionUrlAuthType": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The type of authentication that your function URL uses. " +
+					"Set to AWS_IAM if you want to restrict access to authenticated users only. " +
+					"Set to NONE if you want to bypass IAM authentication to create a public endpoint.",
+				FormattedDescription: "The type of authentication that your function URL uses. " +
+					"Set to `AWS_IAM` if you want to restrict access to authenticated users only. " +
+					"Set to `NONE` if you want to bypass IAM authentication to create a public endpoint. " +
+					"For more information, see [Security and auth model for Lambda function URLs](https://docs.aws.amazon.com/lambda/latest/dg/urls-auth.html).",
+				AllowedValues: []*core.MappingNode{
+					core.MappingNodeFromString("AWS_IAM"),
+					core.MappingNodeFromString("NONE"),
+				},
+				MustRecreate: true,
+			},
+			"eventSourceToken": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "For Alexa Smart Home functions, a token that the invoker must supply.",
+				Pattern:      "^[a-zA-Z0-9._\\-]+$",
+				MinLength:    1,
+				MaxLength:    256,
+				MustRecreate: true,
+			},
+
+			// Computed fields
+			"id": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The statement identifier (Sid) of the permission in the function's resource-based policy.",
+				Computed:    true,
+			},
+		},
+	}
+}
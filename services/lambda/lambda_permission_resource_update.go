@@ -0,0 +1,34 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaPermissionResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	// All the fields of a permission require the resource to be recreated
+	// when they change, as a statement in a resource-based policy can not be
+	// modified in place. There is nothing to update, so the current computed
+	// fields are returned.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	id, err := core.GetPathValue(
+		"$.id",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": id,
+		},
+	}, nil
+}
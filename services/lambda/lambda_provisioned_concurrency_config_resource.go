@@ -0,0 +1,77 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// ProvisionedConcurrencyConfigResource returns a resource implementation for the
+// provisioned concurrency configuration of an AWS Lambda function alias or version.
+func ProvisionedConcurrencyConfigResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_provisioned_concurrency_config_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_provisioned_concurrency_config_jsonc.md")
+
+	lambdaProvisionedConcurrencyConfigActions := &lambdaProvisionedConcurrencyConfigResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:  "aws/lambda/provisionedConcurrencyConfig",
+		Label: "AWS Lambda Provisioned Concurrency Config",
+		PlainTextSummary: "A resource for managing pre-initialised execution environments " +
+			"for an AWS Lambda function alias or version.",
+		FormattedDescription: "The resource type used to define " +
+			"[provisioned concurrency](https://docs.aws.amazon.com/lambda/latest/dg/provisioned-concurrency.html) " +
+			"for a Lambda function alias or version deployed to AWS. " +
+			"The resource is only considered stable once all of the requested execution environments have been allocated. " +
+			"This should not be used for an alias or version that also defines `provisionedConcurrencyConfig` " +
+			"in its own spec.",
+		Schema:  lambdaProvisionedConcurrencyConfigResourceSchema(),
+		IDField: "id",
+		// Provisioned concurrency configuration is not usually referenced by other resources.
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaProvisionedConcurrencyConfigActions.GetExternalState,
+		CreateFunc:           lambdaProvisionedConcurrencyConfigActions.Create,
+		UpdateFunc:           lambdaProvisionedConcurrencyConfigActions.Update,
+		DestroyFunc:          lambdaProvisionedConcurrencyConfigActions.Destroy,
+		StabilisedFunc:       lambdaProvisionedConcurrencyConfigActions.Stabilised,
+	}
+}
+
+type lambdaProvisionedConcurrencyConfigResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaProvisionedConcurrencyConfigResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
+
+// provisionedConcurrencyConfigID derives the ID of a provisioned concurrency configuration
+// from the function name and qualifier as AWS does not assign an identifier
+// to the configuration.
+func provisionedConcurrencyConfigID(functionName string, qualifier string) string {
+	return fmt.Sprintf("%s:%s", functionName, qualifier)
+}
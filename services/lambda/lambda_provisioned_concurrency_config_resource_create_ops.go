@@ -0,0 +1,52 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type provisionedConcurrencyConfigCreate struct {
+	input *lambda.PutProvisionedConcurrencyConfigInput
+}
+
+func (u *provisionedConcurrencyConfigCreate) Name() string {
+	return "create provisioned concurrency config"
+}
+
+func (u *provisionedConcurrencyConfigCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToCreateProvisionedConcurrencyConfigInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *provisionedConcurrencyConfigCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	putOutput, err := lambdaService.PutProvisionedConcurrencyConfig(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = provisionedConcurrencyConfigID(
+		aws.ToString(u.input.FunctionName),
+		aws.ToString(u.input.Qualifier),
+	)
+	newSaveOpCtx.Data["putProvisionedConcurrencyConfigOutput"] = putOutput
+
+	return newSaveOpCtx, nil
+}
@@ -0,0 +1,159 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaProvisionedConcurrencyConfigResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaProvisionedConcurrencyConfigResourceCreateSuite) Test_create_lambda_provisioned_concurrency_config() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createProvisionedConcurrencyConfigTestCase(providerCtx, loader),
+		createProvisionedConcurrencyConfigFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		ProvisionedConcurrencyConfigResource,
+		&s.Suite,
+	)
+}
+
+func createProvisionedConcurrencyConfigTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutProvisionedConcurrencyConfigOutput(&lambda.PutProvisionedConcurrencyConfigOutput{
+			RequestedProvisionedConcurrentExecutions: aws.Int32(10),
+			Status:                                   types.ProvisionedConcurrencyStatusEnumInProgress,
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                    core.MappingNodeFromString("test-function"),
+			"qualifier":                       core.MappingNodeFromString("live"),
+			"provisionedConcurrentExecutions": core.MappingNodeFromInt(10),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create provisioned concurrency config for an alias",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createProvisionedConcurrencyConfigDeployInput(specData, providerCtx),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("test-function:live"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+				FunctionName:                    aws.String("test-function"),
+				Qualifier:                       aws.String("live"),
+				ProvisionedConcurrentExecutions: aws.Int32(10),
+			},
+		},
+	}
+}
+
+func createProvisionedConcurrencyConfigFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutProvisionedConcurrencyConfigError(errors.New("failed to put provisioned concurrency config")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                    core.MappingNodeFromString("test-function"),
+			"qualifier":                       core.MappingNodeFromString("3"),
+			"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create provisioned concurrency config failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input:       createProvisionedConcurrencyConfigDeployInput(specData, providerCtx),
+		ExpectError: true,
+	}
+}
+
+func createProvisionedConcurrencyConfigDeployInput(
+	specData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	newFields := []provider.FieldChange{}
+	for fieldName := range specData.Fields {
+		newFields = append(newFields, provider.FieldChange{
+			FieldPath: "spec." + fieldName,
+		})
+	}
+
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-provisioned-concurrency-config-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-provisioned-concurrency-config-id",
+				ResourceName: "TestProvisionedConcurrencyConfig",
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/provisionedConcurrencyConfig",
+					},
+					Spec: specData,
+				},
+			},
+			NewFields: newFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaProvisionedConcurrencyConfigResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaProvisionedConcurrencyConfigResourceCreateSuite))
+}
@@ -0,0 +1,34 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaProvisionedConcurrencyConfigResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceState.SpecData.Fields["functionName"],
+	)
+	qualifier := core.StringValue(
+		input.ResourceState.SpecData.Fields["qualifier"],
+	)
+	_, err = lambdaService.DeleteProvisionedConcurrencyConfig(
+		ctx,
+		&lambda.DeleteProvisionedConcurrencyConfigInput{
+			FunctionName: &functionName,
+			Qualifier:    &qualifier,
+		},
+	)
+	return err
+}
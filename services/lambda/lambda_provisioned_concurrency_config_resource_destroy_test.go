@@ -0,0 +1,99 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaProvisionedConcurrencyConfigResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaProvisionedConcurrencyConfigResourceDestroySuite) Test_destroy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		createProvisionedConcurrencyConfigDestroyTestCase(
+			"successfully deletes provisioned concurrency config",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteProvisionedConcurrencyConfigOutput(&lambda.DeleteProvisionedConcurrencyConfigOutput{}),
+			),
+			false,
+		),
+		createProvisionedConcurrencyConfigDestroyTestCase(
+			"fails to delete provisioned concurrency config",
+			providerCtx,
+			loader,
+			createLambdaServiceMock(
+				WithDeleteProvisionedConcurrencyConfigError(errors.New("failed to delete provisioned concurrency config")),
+			),
+			true,
+		),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		ProvisionedConcurrencyConfigResource,
+		&s.Suite,
+	)
+}
+
+func createProvisionedConcurrencyConfigDestroyTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	service *lambdaServiceMock,
+	expectError bool,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDestroyInput{
+			ProviderContext: providerCtx,
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"functionName":                    core.MappingNodeFromString("test-function"),
+						"qualifier":                       core.MappingNodeFromString("live"),
+						"provisionedConcurrentExecutions": core.MappingNodeFromInt(10),
+						"id":                              core.MappingNodeFromString("test-function:live"),
+					},
+				},
+			},
+		},
+		ExpectError: expectError,
+	}
+}
+
+func TestLambdaProvisionedConcurrencyConfigResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LambdaProvisionedConcurrencyConfigResourceDestroySuite))
+}
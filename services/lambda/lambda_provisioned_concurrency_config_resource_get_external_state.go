@@ -0,0 +1,55 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaProvisionedConcurrencyConfigResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.CurrentResourceSpec.Fields["functionName"],
+	)
+	qualifier := core.StringValue(
+		input.CurrentResourceSpec.Fields["qualifier"],
+	)
+	provisionedConcurrencyOutput, err := lambdaService.GetProvisionedConcurrencyConfig(
+		ctx,
+		&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: &functionName,
+			Qualifier:    &qualifier,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				// The function name and qualifier are sourced from the current spec
+				// as they are not included in the provisioned concurrency configuration
+				// returned by AWS.
+				"functionName": core.MappingNodeFromString(functionName),
+				"qualifier":    core.MappingNodeFromString(qualifier),
+				"provisionedConcurrentExecutions": core.MappingNodeFromInt(
+					int(aws.ToInt32(provisionedConcurrencyOutput.RequestedProvisionedConcurrentExecutions)),
+				),
+				"id": core.MappingNodeFromString(
+					provisionedConcurrencyConfigID(functionName, qualifier),
+				),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,110 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaProvisionedConcurrencyConfigResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaProvisionedConcurrencyConfigResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createProvisionedConcurrencyConfigStateTestCase(providerCtx, loader),
+		createGetProvisionedConcurrencyConfigErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		ProvisionedConcurrencyConfigResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaProvisionedConcurrencyConfigResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaProvisionedConcurrencyConfigResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createProvisionedConcurrencyConfigStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets provisioned concurrency config state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+				RequestedProvisionedConcurrentExecutions: aws.Int32(15),
+				AllocatedProvisionedConcurrentExecutions: aws.Int32(15),
+				AvailableProvisionedConcurrentExecutions: aws.Int32(15),
+				Status:                                   types.ProvisionedConcurrencyStatusEnumReady,
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext:     providerCtx,
+			CurrentResourceSpec: testProvisionedConcurrencyConfigSpec(),
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":                    core.MappingNodeFromString("test-function"),
+					"qualifier":                       core.MappingNodeFromString("live"),
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(15),
+					"id":                              core.MappingNodeFromString("test-function:live"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetProvisionedConcurrencyConfigErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get provisioned concurrency config error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetProvisionedConcurrencyConfigError(errors.New("failed to get provisioned concurrency config")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext:     providerCtx,
+			CurrentResourceSpec: testProvisionedConcurrencyConfigSpec(),
+		},
+		ExpectError: true,
+	}
+}
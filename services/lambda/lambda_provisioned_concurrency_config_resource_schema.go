@@ -0,0 +1,55 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaProvisionedConcurrencyConfigResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaProvisionedConcurrencyConfigDefinition",
+		Description: "The definition of provisioned concurrency for an AWS Lambda function alias or version.",
+		Required:    []string{"functionName", "qualifier", "provisionedConcurrentExecutions"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function that provisioned concurrency is allocated for. " +
+					"This can be the function name, the function ARN or a partial ARN.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"qualifier": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The alias name or version number that provisioned concurrency is allocated for. " +
+					"Provisioned concurrency can not be allocated for the unpublished ($LATEST) version of a function.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("live"),
+					core.MappingNodeFromString("3"),
+				},
+				Pattern:      "^[a-zA-Z0-9-_]+$",
+				MinLength:    1,
+				MaxLength:    128,
+				MustRecreate: true,
+			},
+			"provisionedConcurrentExecutions": {
+				Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The number of execution environments to keep initialised for the alias or version.",
+				Minimum:     core.ScalarFromInt(1),
+			},
+
+			// Computed fields
+			"id": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The identifier of the provisioned concurrency configuration " +
+					"in the form {functionName}:{qualifier}.",
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func (l *lambdaProvisionedConcurrencyConfigResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceSpec.Fields["functionName"],
+	)
+	qualifier := core.StringValue(
+		input.ResourceSpec.Fields["qualifier"],
+	)
+	provisionedConcurrencyOutput, err := lambdaService.GetProvisionedConcurrencyConfig(
+		ctx,
+		&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: &functionName,
+			Qualifier:    &qualifier,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execution environments are allocated in the background after the
+	// configuration has been saved, the configuration is only stable
+	// once all of the requested environments are ready to serve invocations.
+	if provisionedConcurrencyOutput.Status == types.ProvisionedConcurrencyStatusEnumFailed {
+		return nil, provisionedConcurrencyAllocationError(
+			fmt.Sprintf("%q", provisionedConcurrencyConfigID(functionName, qualifier)),
+			aws.ToString(provisionedConcurrencyOutput.StatusReason),
+		)
+	}
+
+	hasStabilised := provisionedConcurrencyOutput.Status == types.ProvisionedConcurrencyStatusEnumReady
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: hasStabilised,
+	}, nil
+}
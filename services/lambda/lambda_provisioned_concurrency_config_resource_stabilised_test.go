@@ -0,0 +1,160 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaProvisionedConcurrencyConfigResourceStabilisedSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaProvisionedConcurrencyConfigResourceStabilisedSuite) Test_stabilised() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, Service]{
+		{
+			Name: "returns stabilised when provisioned concurrency is ready",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+					Status: types.ProvisionedConcurrencyStatusEnumReady,
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    testProvisionedConcurrencyConfigSpec(),
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: true,
+			},
+		},
+		{
+			Name: "returns not stabilised when provisioned concurrency is being allocated",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+					Status: types.ProvisionedConcurrencyStatusEnumInProgress,
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    testProvisionedConcurrencyConfigSpec(),
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+		},
+		{
+			Name: "fails when provisioned concurrency allocation failed",
+			ServiceFactory: createLambdaServiceMockFactory(
+				WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+					Status:       types.ProvisionedConcurrencyStatusEnumFailed,
+					StatusReason: aws.String("Insufficient account concurrency"),
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec:    testProvisionedConcurrencyConfigSpec(),
+			},
+			ExpectError: true,
+		},
+	}
+
+	plugintestutils.RunResourceHasStabilisedTestCases(
+		testCases,
+		ProvisionedConcurrencyConfigResource,
+		&s.Suite,
+	)
+}
+
+func (s *LambdaProvisionedConcurrencyConfigResourceStabilisedSuite) Test_stabilised_failure_is_a_deploy_error_with_status_reason() {
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	resource := ProvisionedConcurrencyConfigResource(
+		createLambdaServiceMockFactory(
+			WithGetProvisionedConcurrencyConfigOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+				Status:       types.ProvisionedConcurrencyStatusEnumFailed,
+				StatusReason: aws.String("Insufficient account concurrency"),
+			}),
+		),
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			&testutils.MockAWSConfigLoader{},
+		),
+	)
+
+	_, err := resource.HasStabilised(
+		context.Background(),
+		&provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec:    testProvisionedConcurrencyConfigSpec(),
+		},
+	)
+	s.Require().Error(err)
+
+	deployErr, isDeployErr := err.(*provider.ResourceDeployError)
+	s.Require().True(isDeployErr)
+	s.Assert().Equal(
+		[]string{
+			"provisioned concurrency allocation failed for \"test-function:live\": Insufficient account concurrency",
+		},
+		deployErr.FailureReasons,
+	)
+}
+
+func testProvisionedConcurrencyConfigSpec() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                    core.MappingNodeFromString("test-function"),
+			"qualifier":                       core.MappingNodeFromString("live"),
+			"provisionedConcurrentExecutions": core.MappingNodeFromInt(10),
+			"id":                              core.MappingNodeFromString("test-function:live"),
+		},
+	}
+}
+
+func TestLambdaProvisionedConcurrencyConfigResourceStabilisedSuite(t *testing.T) {
+	suite.Run(t, new(LambdaProvisionedConcurrencyConfigResourceStabilisedSuite))
+}
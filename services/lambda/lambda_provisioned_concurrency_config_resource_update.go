@@ -0,0 +1,107 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaProvisionedConcurrencyConfigResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// id is the ID field that must be present in order to update the resource,
+	// the function name and qualifier are used to target the configuration.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	id, err := core.GetPathValue(
+		"$.id",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName, err := core.GetPathValue(
+		"$.functionName",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	qualifier, err := core.GetPathValue(
+		"$.qualifier",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&provisionedConcurrencyConfigStandaloneUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(id),
+			Data: map[string]any{
+				"functionName": core.StringValue(functionName),
+				"qualifier":    core.StringValue(qualifier),
+			},
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": id,
+		},
+	}, nil
+}
+
+func changesToUpdateProvisionedConcurrencyConfigInput(
+	functionName string,
+	qualifier string,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (*lambda.PutProvisionedConcurrencyConfigInput, bool) {
+	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
+
+	input := &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	}
+
+	valueSetter := pluginutils.NewValueSetter(
+		"$.provisionedConcurrentExecutions",
+		func(value *core.MappingNode, input *lambda.PutProvisionedConcurrencyConfigInput) {
+			input.ProvisionedConcurrentExecutions = aws.Int32(int32(core.IntValue(value)))
+		},
+		pluginutils.WithValueSetterCheckIfChanged[*lambda.PutProvisionedConcurrencyConfigInput](true),
+		pluginutils.WithValueSetterModifiedFields[*lambda.PutProvisionedConcurrencyConfigInput](
+			modifiedFields,
+			"spec",
+		),
+	)
+	valueSetter.Set(specData, input)
+
+	return input, valueSetter.DidSet()
+}
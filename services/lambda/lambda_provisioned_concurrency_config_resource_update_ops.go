@@ -0,0 +1,47 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+// provisionedConcurrencyConfigStandaloneUpdate is the update operation for the
+// aws/lambda/provisionedConcurrencyConfig resource, provisionedConcurrencyConfigUpdate
+// is used for the provisioned concurrency configuration embedded in
+// alias and version resources.
+type provisionedConcurrencyConfigStandaloneUpdate struct {
+	input *lambda.PutProvisionedConcurrencyConfigInput
+}
+
+func (u *provisionedConcurrencyConfigStandaloneUpdate) Name() string {
+	return "provisioned concurrency config"
+}
+
+func (u *provisionedConcurrencyConfigStandaloneUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	functionName, qualifier := functionQualifierFromSaveOpContext(saveOpCtx)
+	input, hasUpdates := changesToUpdateProvisionedConcurrencyConfigInput(
+		functionName,
+		qualifier,
+		specData,
+		changes,
+	)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *provisionedConcurrencyConfigStandaloneUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.PutProvisionedConcurrencyConfig(ctx, u.input)
+	return saveOpCtx, err
+}
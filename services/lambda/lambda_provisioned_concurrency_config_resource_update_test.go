@@ -0,0 +1,211 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaProvisionedConcurrencyConfigResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaProvisionedConcurrencyConfigResourceUpdateSuite) Test_update_lambda_provisioned_concurrency_config() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createProvisionedConcurrencyConfigExecutionsUpdateTestCase(providerCtx, loader),
+		createProvisionedConcurrencyConfigNoChangesUpdateTestCase(providerCtx, loader),
+		createProvisionedConcurrencyConfigUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		ProvisionedConcurrencyConfigResource,
+		&s.Suite,
+	)
+}
+
+func createProvisionedConcurrencyConfigExecutionsUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutProvisionedConcurrencyConfigOutput(&lambda.PutProvisionedConcurrencyConfigOutput{}),
+	)
+
+	currentStateSpecData := testProvisionedConcurrencyConfigSpec()
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                    core.MappingNodeFromString("test-function"),
+			"qualifier":                       core.MappingNodeFromString("live"),
+			"provisionedConcurrentExecutions": core.MappingNodeFromInt(25),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update provisioned concurrent executions",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createProvisionedConcurrencyConfigUpdateInput(
+			currentStateSpecData,
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.provisionedConcurrentExecutions",
+				},
+			},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("test-function:live"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+				FunctionName:                    aws.String("test-function"),
+				Qualifier:                       aws.String("live"),
+				ProvisionedConcurrentExecutions: aws.Int32(25),
+			},
+		},
+	}
+}
+
+func createProvisionedConcurrencyConfigNoChangesUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock()
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update provisioned concurrency config with no changes",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createProvisionedConcurrencyConfigUpdateInput(
+			testProvisionedConcurrencyConfigSpec(),
+			testProvisionedConcurrencyConfigSpec(),
+			[]provider.FieldChange{},
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("test-function:live"),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"PutProvisionedConcurrencyConfig",
+		},
+	}
+}
+
+func createProvisionedConcurrencyConfigUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithPutProvisionedConcurrencyConfigError(errors.New("failed to put provisioned concurrency config")),
+	)
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":                    core.MappingNodeFromString("test-function"),
+			"qualifier":                       core.MappingNodeFromString("live"),
+			"provisionedConcurrentExecutions": core.MappingNodeFromInt(2),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update provisioned concurrency config failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: createProvisionedConcurrencyConfigUpdateInput(
+			testProvisionedConcurrencyConfigSpec(),
+			updatedSpecData,
+			[]provider.FieldChange{
+				{
+					FieldPath: "spec.provisionedConcurrentExecutions",
+				},
+			},
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
+
+func createProvisionedConcurrencyConfigUpdateInput(
+	currentStateSpecData *core.MappingNode,
+	updatedSpecData *core.MappingNode,
+	modifiedFields []provider.FieldChange,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-provisioned-concurrency-config-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-provisioned-concurrency-config-id",
+				ResourceName: "TestProvisionedConcurrencyConfig",
+				InstanceID:   "test-instance-id",
+				CurrentResourceState: &state.ResourceState{
+					ResourceID: "test-provisioned-concurrency-config-id",
+					Name:       "TestProvisionedConcurrencyConfig",
+					InstanceID: "test-instance-id",
+					SpecData:   currentStateSpecData,
+				},
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/provisionedConcurrencyConfig",
+					},
+					Spec: updatedSpecData,
+				},
+			},
+			ModifiedFields: modifiedFields,
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func TestLambdaProvisionedConcurrencyConfigResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaProvisionedConcurrencyConfigResourceUpdateSuite))
+}
@@ -245,6 +245,482 @@ type Service interface {
 		params *lambda.CreateFunctionInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.CreateFunctionOutput, error)
+	// Creates a [version] from the current code and configuration of a function. Use versions
+	// to create a snapshot of your function code and configuration that doesn't
+	// change.
+	//
+	// Lambda doesn't publish a version if the function's configuration and code
+	// haven't changed since the last version. Use UpdateFunctionCodeor UpdateFunctionConfiguration to update the function before
+	// publishing a version.
+	//
+	// Clients can invoke versions directly or with an alias. To create an alias, use CreateAlias.
+	//
+	// [version]: https://docs.aws.amazon.com/lambda/latest/dg/versioning-aliases.html
+	PublishVersion(
+		ctx context.Context,
+		params *lambda.PublishVersionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.PublishVersionOutput, error)
+	// Returns a list of [versions], with the version-specific configuration of each. Lambda
+	// returns up to 50 versions per call.
+	//
+	// [versions]: https://docs.aws.amazon.com/lambda/latest/dg/versioning-aliases.html
+	ListVersionsByFunction(
+		ctx context.Context,
+		params *lambda.ListVersionsByFunctionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListVersionsByFunctionOutput, error)
+	// Adds a provisioned concurrency configuration to a function's alias or version.
+	PutProvisionedConcurrencyConfig(
+		ctx context.Context,
+		params *lambda.PutProvisionedConcurrencyConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.PutProvisionedConcurrencyConfigOutput, error)
+	// Retrieves the provisioned concurrency configuration for a function's alias or
+	// version.
+	GetProvisionedConcurrencyConfig(
+		ctx context.Context,
+		params *lambda.GetProvisionedConcurrencyConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetProvisionedConcurrencyConfigOutput, error)
+	// Deletes the provisioned concurrency configuration for a function.
+	DeleteProvisionedConcurrencyConfig(
+		ctx context.Context,
+		params *lambda.DeleteProvisionedConcurrencyConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error)
+	// Retrieves the runtime management configuration for a function's version. If the
+	// runtime update mode is Manual, this includes the ARN of the runtime version and
+	// the runtime update mode. If the runtime update mode is Auto or Function update,
+	// this includes the runtime update mode and null is returned for the ARN. For
+	// more information, see [Runtime updates].
+	//
+	// [Runtime updates]: https://docs.aws.amazon.com/lambda/latest/dg/runtimes-update.html
+	GetRuntimeManagementConfig(
+		ctx context.Context,
+		params *lambda.GetRuntimeManagementConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetRuntimeManagementConfigOutput, error)
+	// Creates an [alias] for a Lambda function version. Use aliases to provide clients with
+	// a function identifier that you can update to invoke a different version.
+	//
+	// You can also map an alias to split invocation requests between two versions.
+	// Use the RoutingConfig parameter to specify a second version and the percentage
+	// of invocation requests that it receives.
+	//
+	// [alias]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-aliases.html
+	CreateAlias(
+		ctx context.Context,
+		params *lambda.CreateAliasInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.CreateAliasOutput, error)
+	// Updates the configuration of a Lambda function [alias].
+	//
+	// [alias]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-aliases.html
+	UpdateAlias(
+		ctx context.Context,
+		params *lambda.UpdateAliasInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.UpdateAliasOutput, error)
+	// Deletes a Lambda function [alias].
+	//
+	// [alias]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-aliases.html
+	DeleteAlias(
+		ctx context.Context,
+		params *lambda.DeleteAliasInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteAliasOutput, error)
+	// Returns details about a Lambda function [alias].
+	//
+	// [alias]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-aliases.html
+	GetAlias(
+		ctx context.Context,
+		params *lambda.GetAliasInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetAliasOutput, error)
+	// Grants a [principal] permission to use a function. You can apply the policy at the
+	// function level, or specify a qualifier to restrict access to a single version or
+	// alias. If you use a qualifier, the invoker must use the full Amazon Resource
+	// Name (ARN) of that version or alias to invoke the function. Note: Lambda does
+	// not support adding policies to version $LATEST.
+	//
+	// To grant permission to another account, specify the account ID as the Principal
+	// . To grant permission to an organization defined in Organizations, specify the
+	// organization ID as the PrincipalOrgID . For Amazon Web Services services, the
+	// principal is a domain-style identifier that the service defines, such as
+	// s3.amazonaws.com or sns.amazonaws.com . For Amazon Web Services services, you
+	// can also specify the ARN of the associated resource as the SourceArn . If you
+	// grant permission to a service principal without specifying the source, other
+	// accounts could potentially configure resources in their account to invoke your
+	// Lambda function.
+	//
+	// This operation adds a statement to a resource-based permissions policy for the
+	// function. For more information about function policies, see [Using resource-based policies for Lambda].
+	//
+	// [principal]: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html#Principal_specifying
+	// [Using resource-based policies for Lambda]: https://docs.aws.amazon.com/lambda/latest/dg/access-control-resource-based.html
+	AddPermission(
+		ctx context.Context,
+		params *lambda.AddPermissionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.AddPermissionOutput, error)
+	// Revokes function-use permission from an Amazon Web Services service or another
+	// Amazon Web Services account. You can get the ID of the statement from the output
+	// of GetPolicy.
+	RemovePermission(
+		ctx context.Context,
+		params *lambda.RemovePermissionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.RemovePermissionOutput, error)
+	// Returns the [resource-based IAM policy] for a function, version, or alias.
+	//
+	// [resource-based IAM policy]: https://docs.aws.amazon.com/lambda/latest/dg/access-control-resource-based.html
+	GetPolicy(
+		ctx context.Context,
+		params *lambda.GetPolicyInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetPolicyOutput, error)
+	// Creates a mapping between an event source and an Lambda function. Lambda reads
+	// items from the event source and invokes the function.
+	//
+	// For details about how to configure different event sources, see the following
+	// topics.
+	//
+	// [Amazon DynamoDB Streams]
+	//
+	// [Amazon Kinesis]
+	//
+	// [Amazon SQS]
+	//
+	// [Amazon MQ and RabbitMQ]
+	//
+	// [Amazon MSK]
+	//
+	// [Apache Kafka]
+	//
+	// [Amazon DocumentDB]
+	//
+	// The following error handling options are available only for DynamoDB and
+	// Kinesis event sources:
+	//
+	//   - BisectBatchOnFunctionError – If the function returns an error, split the
+	//     batch in two and retry.
+	//
+	//   - MaximumRecordAgeInSeconds – Discard records older than the specified age.
+	//     The default value is infinite (-1). When set to infinite (-1), failed records
+	//     are retried until the record expires
+	//
+	//   - MaximumRetryAttempts – Discard records after the specified number of
+	//     retries. The default value is infinite (-1). When set to infinite (-1), failed
+	//     records are retried until the record expires.
+	//
+	//   - ParallelizationFactor – Process multiple batches from each shard
+	//     concurrently.
+	//
+	// For stream sources (DynamoDB, Kinesis, Amazon MSK, and self-managed Apache
+	// Kafka), the following option is also available:
+	//
+	//   - DestinationConfig – Send discarded records to an Amazon SQS queue, Amazon
+	//     SNS topic, or Amazon S3 bucket.
+	//
+	// For information about which configuration parameters apply to each event
+	// source, see the following topics.
+	//
+	// [Amazon DynamoDB Streams]
+	//
+	// [Amazon Kinesis]
+	//
+	// [Amazon SQS]
+	//
+	// [Amazon MQ and RabbitMQ]
+	//
+	// [Amazon MSK]
+	//
+	// [Apache Kafka]
+	//
+	// [Amazon DocumentDB]
+	//
+	// [Amazon DynamoDB Streams]: https://docs.aws.amazon.com/lambda/latest/dg/with-ddb.html#services-ddb-params
+	// [Amazon SQS]: https://docs.aws.amazon.com/lambda/latest/dg/with-sqs.html#services-sqs-params
+	// [Amazon MSK]: https://docs.aws.amazon.com/lambda/latest/dg/with-msk.html#services-msk-parms
+	// [Amazon Kinesis]: https://docs.aws.amazon.com/lambda/latest/dg/with-kinesis.html#services-kinesis-params
+	// [Amazon MQ and RabbitMQ]: https://docs.aws.amazon.com/lambda/latest/dg/with-mq.html#services-mq-params
+	// [Apache Kafka]: https://docs.aws.amazon.com/lambda/latest/dg/with-kafka.html#services-kafka-parms
+	// [Amazon DocumentDB]: https://docs.aws.amazon.com/lambda/latest/dg/with-documentdb.html#docdb-configuration
+	CreateEventSourceMapping(
+		ctx context.Context,
+		params *lambda.CreateEventSourceMappingInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.CreateEventSourceMappingOutput, error)
+	// Updates an event source mapping. You can change the function that Lambda
+	// invokes, or pause invocation and resume later from the same location.
+	//
+	// For details about how to configure different event sources, see the following
+	// topics.
+	//
+	// [Amazon DynamoDB Streams]
+	//
+	// [Amazon Kinesis]
+	//
+	// [Amazon SQS]
+	//
+	// [Amazon MQ and RabbitMQ]
+	//
+	// [Amazon MSK]
+	//
+	// [Apache Kafka]
+	//
+	// [Amazon DocumentDB]
+	//
+	// The following error handling options are available only for DynamoDB and
+	// Kinesis event sources:
+	//
+	//   - BisectBatchOnFunctionError – If the function returns an error, split the
+	//     batch in two and retry.
+	//
+	//   - MaximumRecordAgeInSeconds – Discard records older than the specified age.
+	//     The default value is infinite (-1). When set to infinite (-1), failed records
+	//     are retried until the record expires
+	//
+	//   - MaximumRetryAttempts – Discard records after the specified number of
+	//     retries. The default value is infinite (-1). When set to infinite (-1), failed
+	//     records are retried until the record expires.
+	//
+	//   - ParallelizationFactor – Process multiple batches from each shard
+	//     concurrently.
+	//
+	// For stream sources (DynamoDB, Kinesis, Amazon MSK, and self-managed Apache
+	// Kafka), the following option is also available:
+	//
+	//   - DestinationConfig – Send discarded records to an Amazon SQS queue, Amazon
+	//     SNS topic, or Amazon S3 bucket.
+	//
+	// For information about which configuration parameters apply to each event
+	// source, see the following topics.
+	//
+	// [Amazon DynamoDB Streams]
+	//
+	// [Amazon Kinesis]
+	//
+	// [Amazon SQS]
+	//
+	// [Amazon MQ and RabbitMQ]
+	//
+	// [Amazon MSK]
+	//
+	// [Apache Kafka]
+	//
+	// [Amazon DocumentDB]
+	//
+	// [Amazon DynamoDB Streams]: https://docs.aws.amazon.com/lambda/latest/dg/with-ddb.html#services-ddb-params
+	// [Amazon SQS]: https://docs.aws.amazon.com/lambda/latest/dg/with-sqs.html#services-sqs-params
+	// [Amazon MSK]: https://docs.aws.amazon.com/lambda/latest/dg/with-msk.html#services-msk-parms
+	// [Amazon Kinesis]: https://docs.aws.amazon.com/lambda/latest/dg/with-kinesis.html#services-kinesis-params
+	// [Amazon MQ and RabbitMQ]: https://docs.aws.amazon.com/lambda/latest/dg/with-mq.html#services-mq-params
+	// [Apache Kafka]: https://docs.aws.amazon.com/lambda/latest/dg/with-kafka.html#services-kafka-parms
+	// [Amazon DocumentDB]: https://docs.aws.amazon.com/lambda/latest/dg/with-documentdb.html#docdb-configuration
+	UpdateEventSourceMapping(
+		ctx context.Context,
+		params *lambda.UpdateEventSourceMappingInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.UpdateEventSourceMappingOutput, error)
+	// Deletes an [event source mapping]. You can get the identifier of a mapping from the output of ListEventSourceMappings.
+	//
+	// When you delete an event source mapping, it enters a Deleting state and might
+	// not be completely deleted for several seconds.
+	//
+	// [event source mapping]: https://docs.aws.amazon.com/lambda/latest/dg/intro-invocation-modes.html
+	DeleteEventSourceMapping(
+		ctx context.Context,
+		params *lambda.DeleteEventSourceMappingInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteEventSourceMappingOutput, error)
+	// Returns details about an event source mapping. You can get the identifier of a
+	// mapping from the output of ListEventSourceMappings.
+	GetEventSourceMapping(
+		ctx context.Context,
+		params *lambda.GetEventSourceMappingInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetEventSourceMappingOutput, error)
+	// Creates a Lambda function URL with the specified configuration parameters. A
+	// function URL is a dedicated HTTP(S) endpoint that you can use to invoke your
+	// function.
+	CreateFunctionUrlConfig(
+		ctx context.Context,
+		params *lambda.CreateFunctionUrlConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.CreateFunctionUrlConfigOutput, error)
+	// Updates the configuration for a Lambda function URL.
+	UpdateFunctionUrlConfig(
+		ctx context.Context,
+		params *lambda.UpdateFunctionUrlConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.UpdateFunctionUrlConfigOutput, error)
+	// Deletes a Lambda function URL. When you delete a function URL, you can't
+	// recover it. Creating a new function URL results in a different URL address.
+	DeleteFunctionUrlConfig(
+		ctx context.Context,
+		params *lambda.DeleteFunctionUrlConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteFunctionUrlConfigOutput, error)
+	// Returns details about a Lambda function URL.
+	GetFunctionUrlConfig(
+		ctx context.Context,
+		params *lambda.GetFunctionUrlConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetFunctionUrlConfigOutput, error)
+	// Creates an [Lambda layer] from a ZIP archive. Each time you call PublishLayerVersion with the
+	// same layer name, a new version is created.
+	//
+	// Add layers to your function with CreateFunction or UpdateFunctionConfiguration.
+	//
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html
+	PublishLayerVersion(
+		ctx context.Context,
+		params *lambda.PublishLayerVersionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.PublishLayerVersionOutput, error)
+	// Returns information about a version of an [Lambda layer], with a link to download the layer
+	// archive that's valid for 10 minutes.
+	//
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html
+	GetLayerVersion(
+		ctx context.Context,
+		params *lambda.GetLayerVersionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetLayerVersionOutput, error)
+	// Deletes a version of an [Lambda layer]. Deleted versions can no longer be viewed or added to
+	// functions. To avoid breaking functions, a copy of the version remains in Lambda
+	// until no functions refer to it.
+	//
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html
+	DeleteLayerVersion(
+		ctx context.Context,
+		params *lambda.DeleteLayerVersionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteLayerVersionOutput, error)
+	// Adds permissions to the resource-based policy of a version of an [Lambda layer]. Use this
+	// action to grant layer usage permission to other accounts. You can grant
+	// permission to a single account, all accounts in an organization, or all Amazon
+	// Web Services accounts.
+	//
+	// To revoke permission, call RemoveLayerVersionPermission with the statement ID that you specified when you
+	// added it.
+	//
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html
+	AddLayerVersionPermission(
+		ctx context.Context,
+		params *lambda.AddLayerVersionPermissionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.AddLayerVersionPermissionOutput, error)
+	// Removes a statement from the permissions policy for a version of an [Lambda layer]. For more
+	// information, see AddLayerVersionPermission.
+	//
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html
+	RemoveLayerVersionPermission(
+		ctx context.Context,
+		params *lambda.RemoveLayerVersionPermissionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.RemoveLayerVersionPermissionOutput, error)
+	// Returns the permission policy for a version of an [Lambda layer]. For more information, see AddLayerVersionPermission.
+	//
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html
+	GetLayerVersionPolicy(
+		ctx context.Context,
+		params *lambda.GetLayerVersionPolicyInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetLayerVersionPolicyOutput, error)
+	// Creates a code signing configuration. A [code signing configuration] defines a list of allowed signing
+	// profiles and defines the code-signing validation policy (action to be taken if
+	// deployment validation checks fail).
+	//
+	// [code signing configuration]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-codesigning.html
+	CreateCodeSigningConfig(
+		ctx context.Context,
+		params *lambda.CreateCodeSigningConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.CreateCodeSigningConfigOutput, error)
+	// Update the code signing configuration. Changes to the code signing
+	// configuration take effect the next time a user tries to deploy a code package to
+	// the function.
+	UpdateCodeSigningConfig(
+		ctx context.Context,
+		params *lambda.UpdateCodeSigningConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.UpdateCodeSigningConfigOutput, error)
+	// Deletes the code signing configuration. You can delete the code signing
+	// configuration only if no function is using it.
+	DeleteCodeSigningConfig(
+		ctx context.Context,
+		params *lambda.DeleteCodeSigningConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteCodeSigningConfigOutput, error)
+	// Returns information about the specified code signing configuration.
+	GetCodeSigningConfig(
+		ctx context.Context,
+		params *lambda.GetCodeSigningConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetCodeSigningConfigOutput, error)
+	// Returns a function, event source mapping, or code signing configuration's [tags]. You
+	// can also view function tags with GetFunction.
+	//
+	// [tags]: https://docs.aws.amazon.com/lambda/latest/dg/tagging.html
+	ListTags(
+		ctx context.Context,
+		params *lambda.ListTagsInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListTagsOutput, error)
+	// Removes the code signing configuration from the function.
+	DeleteFunctionCodeSigningConfig(
+		ctx context.Context,
+		params *lambda.DeleteFunctionCodeSigningConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteFunctionCodeSigningConfigOutput, error)
+	// Configures options for [asynchronous invocation] on a function, version, or alias. If a configuration
+	// already exists for a function, version, or alias, this operation overwrites it.
+	// If you exclude any settings, they are removed. To set one option without
+	// affecting existing settings for other options, use UpdateFunctionEventInvokeConfig.
+	//
+	// By default, Lambda retries an asynchronous invocation twice if the function
+	// returns an error. It retains events in a queue for up to six hours. When an
+	// event fails all processing attempts or stays in the asynchronous invocation
+	// queue for too long, Lambda discards it. To retain discarded events, configure a
+	// dead-letter queue with UpdateFunctionConfiguration.
+	//
+	// To send an invocation record to a queue, topic, S3 bucket, function, or event
+	// bus, specify a [destination]. You can configure separate destinations for successful
+	// invocations (on-success) and events that fail all processing attempts
+	// (on-failure). You can configure destinations in addition to or instead of a
+	// dead-letter queue.
+	//
+	// S3 buckets are supported only for on-failure destinations. To retain records of
+	// successful invocations, use another destination type.
+	//
+	// [asynchronous invocation]: https://docs.aws.amazon.com/lambda/latest/dg/invocation-async.html
+	// [destination]: https://docs.aws.amazon.com/lambda/latest/dg/invocation-async.html#invocation-async-destinations
+	PutFunctionEventInvokeConfig(
+		ctx context.Context,
+		params *lambda.PutFunctionEventInvokeConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.PutFunctionEventInvokeConfigOutput, error)
+	// Retrieves the configuration for asynchronous invocation for a function,
+	// version, or alias.
+	//
+	// To configure options for asynchronous invocation, use PutFunctionEventInvokeConfig.
+	GetFunctionEventInvokeConfig(
+		ctx context.Context,
+		params *lambda.GetFunctionEventInvokeConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetFunctionEventInvokeConfigOutput, error)
+	// Deletes the configuration for asynchronous invocation for a function, version,
+	// or alias.
+	//
+	// To configure options for asynchronous invocation, use PutFunctionEventInvokeConfig.
+	DeleteFunctionEventInvokeConfig(
+		ctx context.Context,
+		params *lambda.DeleteFunctionEventInvokeConfigInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.DeleteFunctionEventInvokeConfigOutput, error)
 }
 
 // NewService creates a new instance of the AWS Lambda service
@@ -0,0 +1,64 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/providerv1"
+)
+
+// URLResource returns a resource implementation for an AWS Lambda Function URL.
+func URLResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	yamlExample, _ := examples.ReadFile("examples/resources/lambda_url_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_url_jsonc.md")
+
+	lambdaURLActions := &lambdaURLResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/url",
+		Label:            "AWS Lambda Function URL",
+		PlainTextSummary: "A resource for managing a dedicated HTTP(S) endpoint for an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define a [function URL](https://docs.aws.amazon.com/lambda/latest/dg/urls-configuration.html) " +
+			"that provides a dedicated HTTP(S) endpoint for a Lambda function or function alias deployed to AWS.",
+		Schema:  lambdaURLResourceSchema(),
+		IDField: "functionArn",
+		// The generated function URL is often referenced by other resources
+		// or exported for clients, so a function URL is not usually a terminal resource.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: lambdaURLActions.GetExternalState,
+		CreateFunc:           lambdaURLActions.Create,
+		UpdateFunc:           lambdaURLActions.Update,
+		DestroyFunc:          lambdaURLActions.Destroy,
+		StabilisedFunc:       lambdaURLActions.Stabilised,
+	}
+}
+
+type lambdaURLResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaURLResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(ctx, providerContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
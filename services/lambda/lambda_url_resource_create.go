@@ -0,0 +1,140 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaURLResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[Service]{
+		&functionURLCreate{},
+	}
+
+	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasUpdates {
+		return nil, fmt.Errorf("no updates were made during function URL creation")
+	}
+
+	createOutput, ok := saveOpCtx.Data["createFunctionUrlConfigOutput"].(*lambda.CreateFunctionUrlConfigOutput)
+	if !ok {
+		return nil, fmt.Errorf("createFunctionUrlConfigOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.functionArn": core.MappingNodeFromString(
+				aws.ToString(createOutput.FunctionArn),
+			),
+			"spec.functionUrl": core.MappingNodeFromString(
+				aws.ToString(createOutput.FunctionUrl),
+			),
+		},
+	}, nil
+}
+
+func changesToCreateFunctionURLInput(
+	specData *core.MappingNode,
+) (*lambda.CreateFunctionUrlConfigInput, bool) {
+	input := &lambda.CreateFunctionUrlConfigInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.CreateFunctionUrlConfigInput]{
+		pluginutils.NewValueSetter(
+			"$.functionName",
+			func(value *core.MappingNode, input *lambda.CreateFunctionUrlConfigInput) {
+				input.FunctionName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.qualifier",
+			func(value *core.MappingNode, input *lambda.CreateFunctionUrlConfigInput) {
+				input.Qualifier = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.authType",
+			func(value *core.MappingNode, input *lambda.CreateFunctionUrlConfigInput) {
+				input.AuthType = types.FunctionUrlAuthType(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.invokeMode",
+			func(value *core.MappingNode, input *lambda.CreateFunctionUrlConfigInput) {
+				input.InvokeMode = types.InvokeMode(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.cors",
+			func(value *core.MappingNode, input *lambda.CreateFunctionUrlConfigInput) {
+				input.Cors = functionURLCorsFromSpec(value)
+			},
+		),
+	}
+
+	hasValues := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValues = hasValues || valueSetter.DidSet()
+	}
+
+	return input, hasValues
+}
+
+func functionURLCorsFromSpec(value *core.MappingNode) *types.Cors {
+	cors := &types.Cors{}
+	if value == nil {
+		return cors
+	}
+
+	if allowCredentials, ok := value.Fields["allowCredentials"]; ok {
+		cors.AllowCredentials = aws.Bool(core.BoolValue(allowCredentials))
+	}
+
+	if allowHeaders, ok := value.Fields["allowHeaders"]; ok {
+		cors.AllowHeaders = core.StringSliceValue(allowHeaders)
+	}
+
+	if allowMethods, ok := value.Fields["allowMethods"]; ok {
+		cors.AllowMethods = core.StringSliceValue(allowMethods)
+	}
+
+	if allowOrigins, ok := value.Fields["allowOrigins"]; ok {
+		cors.AllowOrigins = core.StringSliceValue(allowOrigins)
+	}
+
+	if exposeHeaders, ok := value.Fields["exposeHeaders"]; ok {
+		cors.ExposeHeaders = core.StringSliceValue(exposeHeaders)
+	}
+
+	if maxAge, ok := value.Fields["maxAge"]; ok {
+		cors.MaxAge = aws.Int32(int32(core.IntValue(maxAge)))
+	}
+
+	return cors
+}
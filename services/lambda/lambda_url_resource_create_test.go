@@ -0,0 +1,307 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaURLResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaURLResourceCreateSuite) Test_create_lambda_url() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createBasicFunctionURLTestCase(providerCtx, loader),
+		createFunctionURLWithCorsTestCase(providerCtx, loader),
+		createFunctionURLFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		URLResource,
+		&s.Suite,
+	)
+}
+
+func createBasicFunctionURLTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	service := createLambdaServiceMock(
+		WithCreateFunctionUrlConfigOutput(&lambda.CreateFunctionUrlConfigOutput{
+			FunctionArn: aws.String(functionARN),
+			FunctionUrl: aws.String(functionURL),
+			AuthType:    types.FunctionUrlAuthTypeNone,
+			InvokeMode:  types.InvokeModeBuffered,
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"authType":     core.MappingNodeFromString("NONE"),
+			"invokeMode":   core.MappingNodeFromString("BUFFERED"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create basic function URL",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-url-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-url-id",
+					ResourceName: "TestFunctionURL",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/url",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.authType",
+					},
+					{
+						FieldPath: "spec.invokeMode",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(functionARN),
+				"spec.functionUrl": core.MappingNodeFromString(functionURL),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateFunctionUrlConfig": &lambda.CreateFunctionUrlConfigInput{
+				FunctionName: aws.String("test-function"),
+				AuthType:     types.FunctionUrlAuthTypeNone,
+				InvokeMode:   types.InvokeModeBuffered,
+			},
+		},
+	}
+}
+
+func createFunctionURLWithCorsTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	service := createLambdaServiceMock(
+		WithCreateFunctionUrlConfigOutput(&lambda.CreateFunctionUrlConfigOutput{
+			FunctionArn: aws.String(functionARN),
+			FunctionUrl: aws.String(functionURL),
+			AuthType:    types.FunctionUrlAuthTypeAwsIam,
+			InvokeMode:  types.InvokeModeResponseStream,
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"qualifier":    core.MappingNodeFromString("live"),
+			"authType":     core.MappingNodeFromString("AWS_IAM"),
+			"invokeMode":   core.MappingNodeFromString("RESPONSE_STREAM"),
+			"cors": {
+				Fields: map[string]*core.MappingNode{
+					"allowCredentials": core.MappingNodeFromBool(true),
+					"allowHeaders":     core.MappingNodeFromStringSlice([]string{"content-type"}),
+					"allowMethods":     core.MappingNodeFromStringSlice([]string{"GET", "POST"}),
+					"allowOrigins":     core.MappingNodeFromStringSlice([]string{"https://tools.example.com"}),
+					"exposeHeaders":    core.MappingNodeFromStringSlice([]string{"x-request-id"}),
+					"maxAge":           core.MappingNodeFromInt(300),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create function URL for an alias with CORS settings",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-url-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-url-id",
+					ResourceName: "TestFunctionURL",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/url",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.qualifier",
+					},
+					{
+						FieldPath: "spec.authType",
+					},
+					{
+						FieldPath: "spec.invokeMode",
+					},
+					{
+						FieldPath: "spec.cors.allowCredentials",
+					},
+					{
+						FieldPath: "spec.cors.allowHeaders",
+					},
+					{
+						FieldPath: "spec.cors.allowMethods",
+					},
+					{
+						FieldPath: "spec.cors.allowOrigins",
+					},
+					{
+						FieldPath: "spec.cors.exposeHeaders",
+					},
+					{
+						FieldPath: "spec.cors.maxAge",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(functionARN),
+				"spec.functionUrl": core.MappingNodeFromString(functionURL),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateFunctionUrlConfig": &lambda.CreateFunctionUrlConfigInput{
+				FunctionName: aws.String("test-function"),
+				Qualifier:    aws.String("live"),
+				AuthType:     types.FunctionUrlAuthTypeAwsIam,
+				InvokeMode:   types.InvokeModeResponseStream,
+				Cors: &types.Cors{
+					AllowCredentials: aws.Bool(true),
+					AllowHeaders:     []string{"content-type"},
+					AllowMethods:     []string{"GET", "POST"},
+					AllowOrigins:     []string{"https://tools.example.com"},
+					ExposeHeaders:    []string{"x-request-id"},
+					MaxAge:           aws.Int32(300),
+				},
+			},
+		},
+	}
+}
+
+func createFunctionURLFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	service := createLambdaServiceMock(
+		WithCreateFunctionUrlConfigError(errors.New("failed to create function URL")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"authType":     core.MappingNodeFromString("AWS_IAM"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "create function URL failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-url-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-url-id",
+					ResourceName: "TestFunctionURL",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/url",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+					},
+					{
+						FieldPath: "spec.authType",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaURLResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaURLResourceCreateSuite))
+}
@@ -0,0 +1,144 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaURLResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.CurrentResourceSpec.Fields["functionName"],
+	)
+	getInput := &lambda.GetFunctionUrlConfigInput{
+		FunctionName: &functionName,
+	}
+	qualifier, hasQualifier := pluginutils.GetValueByPath(
+		"$.qualifier",
+		input.CurrentResourceSpec,
+	)
+	if hasQualifier {
+		getInput.Qualifier = aws.String(core.StringValue(qualifier))
+	}
+
+	urlOutput, err := lambdaService.GetFunctionUrlConfig(ctx, getInput)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceSpecState := l.buildBaseResourceSpecState(functionName, urlOutput)
+	if hasQualifier {
+		resourceSpecState.Fields["qualifier"] = qualifier
+	}
+
+	l.addOptionalConfigurationsToSpec(urlOutput, resourceSpecState.Fields)
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *lambdaURLResourceActions) buildBaseResourceSpecState(
+	functionName string,
+	urlOutput *lambda.GetFunctionUrlConfigOutput,
+) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn": core.MappingNodeFromString(
+				aws.ToString(urlOutput.FunctionArn),
+			),
+			"functionUrl": core.MappingNodeFromString(
+				aws.ToString(urlOutput.FunctionUrl),
+			),
+			// The function name and qualifier are sourced from the current spec
+			// so that a function URL defined with a function name
+			// or a partial ARN does not report drift.
+			"functionName": core.MappingNodeFromString(functionName),
+			"authType": core.MappingNodeFromString(
+				string(urlOutput.AuthType),
+			),
+		},
+	}
+}
+
+func (l *lambdaURLResourceActions) addOptionalConfigurationsToSpec(
+	urlOutput *lambda.GetFunctionUrlConfigOutput,
+	specFields map[string]*core.MappingNode,
+) {
+	configurations := []optionalConfiguration{
+		{
+			condition: func() bool { return urlOutput.InvokeMode != "" },
+			field:     "invokeMode",
+			value: func() *core.MappingNode {
+				return core.MappingNodeFromString(string(urlOutput.InvokeMode))
+			},
+		},
+		{
+			condition: func() bool { return urlOutput.Cors != nil && !functionURLCorsIsEmpty(urlOutput.Cors) },
+			field:     "cors",
+			value: func() *core.MappingNode {
+				return functionURLCorsToMappingNode(urlOutput.Cors)
+			},
+		},
+	}
+
+	for _, config := range configurations {
+		if config.condition() {
+			specFields[config.field] = config.value()
+		}
+	}
+}
+
+func functionURLCorsIsEmpty(cors *types.Cors) bool {
+	return cors.AllowCredentials == nil &&
+		len(cors.AllowHeaders) == 0 &&
+		len(cors.AllowMethods) == 0 &&
+		len(cors.AllowOrigins) == 0 &&
+		len(cors.ExposeHeaders) == 0 &&
+		cors.MaxAge == nil
+}
+
+func functionURLCorsToMappingNode(cors *types.Cors) *core.MappingNode {
+	fields := map[string]*core.MappingNode{}
+
+	if cors.AllowCredentials != nil {
+		fields["allowCredentials"] = core.MappingNodeFromBool(aws.ToBool(cors.AllowCredentials))
+	}
+
+	if len(cors.AllowHeaders) > 0 {
+		fields["allowHeaders"] = core.MappingNodeFromStringSlice(cors.AllowHeaders)
+	}
+
+	if len(cors.AllowMethods) > 0 {
+		fields["allowMethods"] = core.MappingNodeFromStringSlice(cors.AllowMethods)
+	}
+
+	if len(cors.AllowOrigins) > 0 {
+		fields["allowOrigins"] = core.MappingNodeFromStringSlice(cors.AllowOrigins)
+	}
+
+	if len(cors.ExposeHeaders) > 0 {
+		fields["exposeHeaders"] = core.MappingNodeFromStringSlice(cors.ExposeHeaders)
+	}
+
+	if cors.MaxAge != nil {
+		fields["maxAge"] = core.MappingNodeFromInt(int(aws.ToInt32(cors.MaxAge)))
+	}
+
+	return &core.MappingNode{
+		Fields: fields,
+	}
+}
@@ -0,0 +1,188 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaURLResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaURLResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		createBasicFunctionURLStateTestCase(providerCtx, loader),
+		createFunctionURLWithCorsStateTestCase(providerCtx, loader),
+		createGetFunctionURLErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		URLResource,
+		&s.Suite,
+	)
+}
+
+func TestLambdaURLResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LambdaURLResourceGetExternalStateSuite))
+}
+
+// Test case generator functions below.
+
+func createBasicFunctionURLStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets basic function URL state",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionUrlConfigOutput(&lambda.GetFunctionUrlConfigOutput{
+				FunctionArn: aws.String(functionARN),
+				FunctionUrl: aws.String(functionURL),
+				AuthType:    types.FunctionUrlAuthTypeNone,
+				InvokeMode:  types.InvokeModeBuffered,
+				Cors:        &types.Cors{},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":  core.MappingNodeFromString(functionARN),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"authType":     core.MappingNodeFromString("AWS_IAM"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":  core.MappingNodeFromString(functionARN),
+					"functionUrl":  core.MappingNodeFromString(functionURL),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"authType":     core.MappingNodeFromString("NONE"),
+					"invokeMode":   core.MappingNodeFromString("BUFFERED"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createFunctionURLWithCorsStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "successfully gets function URL state for an alias with CORS settings",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionUrlConfigOutput(&lambda.GetFunctionUrlConfigOutput{
+				FunctionArn: aws.String(functionARN),
+				FunctionUrl: aws.String(functionURL),
+				AuthType:    types.FunctionUrlAuthTypeAwsIam,
+				InvokeMode:  types.InvokeModeResponseStream,
+				Cors: &types.Cors{
+					AllowCredentials: aws.Bool(true),
+					AllowMethods:     []string{"GET", "POST"},
+					AllowOrigins:     []string{"https://tools.example.com"},
+					MaxAge:           aws.Int32(300),
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":  core.MappingNodeFromString(functionARN),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"qualifier":    core.MappingNodeFromString("live"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn":  core.MappingNodeFromString(functionARN),
+					"functionUrl":  core.MappingNodeFromString(functionURL),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"qualifier":    core.MappingNodeFromString("live"),
+					"authType":     core.MappingNodeFromString("AWS_IAM"),
+					"invokeMode":   core.MappingNodeFromString("RESPONSE_STREAM"),
+					"cors": {
+						Fields: map[string]*core.MappingNode{
+							"allowCredentials": core.MappingNodeFromBool(true),
+							"allowMethods":     core.MappingNodeFromStringSlice([]string{"GET", "POST"}),
+							"allowOrigins":     core.MappingNodeFromStringSlice([]string{"https://tools.example.com"}),
+							"maxAge":           core.MappingNodeFromInt(300),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetFunctionURLErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, Service]{
+		Name: "handles get function URL error",
+		ServiceFactory: createLambdaServiceMockFactory(
+			WithGetFunctionUrlConfigError(errors.New("failed to get function URL")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,147 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+func lambdaURLResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaFunctionURLDefinition",
+		Description: "The definition of a function URL for an AWS Lambda function.",
+		Required:    []string{"functionName", "authType"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function that the URL is for. " +
+					"This can be the function name, the function ARN or a partial ARN.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("MyFunction"),
+					core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:function:MyFunction"),
+				},
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"qualifier": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The alias name that the function URL is for.",
+				Examples: []*core.MappingNode{
+					core.MappingNodeFromString("live"),
+				},
+				// The pattern in the official AWS API documentation for qualifiers uses
+				// a negative lookahead to prevent numeric version qualifiers,
+				// which is not supported by Go's regexp engine.
+				// Due to this, version numbers will only be rejected by AWS
+				// when the function URL is created.
+				Pattern:      "^[a-zA-Z0-9-_]+$",
+				MinLength:    1,
+				MaxLength:    128,
+				MustRecreate: true,
+			},
+			"authType": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The type of authentication that the function URL uses. Set to AWS_IAM to restrict access " +
+					"to authenticated users only or NONE to bypass IAM authentication and create a public endpoint.",
+				FormattedDescription: "The type of authentication that the function URL uses. Set to `AWS_IAM` to restrict access " +
+					"to authenticated users only or `NONE` to bypass IAM authentication and create a public endpoint. " +
+					"See [security and auth model for Lambda function URLs](https://docs.aws.amazon.com/lambda/latest/dg/urls-auth.html) for more information.",
+				AllowedValues: []*core.MappingNode{
+					core.MappingNodeFromString("AWS_IAM"),
+					core.MappingNodeFromString("NONE"),
+				},
+			},
+			"invokeMode": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "Determines how the Lambda function responds to an invocation. " +
+					"BUFFERED returns the response once the function has completed, " +
+					"RESPONSE_STREAM streams the response payload as it becomes available.",
+				FormattedDescription: "Determines how the Lambda function responds to an invocation. " +
+					"`BUFFERED` returns the response once the function has completed, " +
+					"`RESPONSE_STREAM` [streams the response](https://docs.aws.amazon.com/lambda/latest/dg/configuration-response-streaming.html) " +
+					"payload as it becomes available.",
+				Default: core.MappingNodeFromString("BUFFERED"),
+				AllowedValues: []*core.MappingNode{
+					core.MappingNodeFromString("BUFFERED"),
+					core.MappingNodeFromString("RESPONSE_STREAM"),
+				},
+			},
+			"cors": {
+				Type:        provider.ResourceDefinitionsSchemaTypeObject,
+				Label:       "FunctionURLCors",
+				Description: "The cross-origin resource sharing (CORS) settings for the function URL.",
+				FormattedDescription: "The [cross-origin resource sharing (CORS)](https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS) " +
+					"settings for the function URL.",
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"allowCredentials": {
+						Type:        provider.ResourceDefinitionsSchemaTypeBoolean,
+						Description: "Whether to allow cookies or other credentials in requests to the function URL.",
+					},
+					"allowHeaders": {
+						Type:        provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "The HTTP headers that origins can include in requests to the function URL.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type:      provider.ResourceDefinitionsSchemaTypeString,
+							MaxLength: 1024,
+						},
+						MaxLength: 100,
+					},
+					"allowMethods": {
+						Type:        provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "The HTTP methods that are allowed when calling the function URL.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+							Examples: []*core.MappingNode{
+								core.MappingNodeFromString("GET"),
+								core.MappingNodeFromString("POST"),
+								core.MappingNodeFromString("*"),
+							},
+							MaxLength: 6,
+						},
+						MaxLength: 6,
+					},
+					"allowOrigins": {
+						Type:        provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "The origins that can access the function URL.",
+						FormattedDescription: "The origins that can access the function URL. " +
+							"You can list any number of specific origins, separated by a comma, or grant access to all origins with `*`.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type:      provider.ResourceDefinitionsSchemaTypeString,
+							MinLength: 1,
+							MaxLength: 253,
+						},
+						MaxLength: 100,
+					},
+					"exposeHeaders": {
+						Type:        provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "The HTTP headers in the function response that you want to expose to origins that call the function URL.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type:      provider.ResourceDefinitionsSchemaTypeString,
+							MaxLength: 1024,
+						},
+						MaxLength: 100,
+					},
+					"maxAge": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The maximum amount of time, in seconds, that web browsers can cache results of a preflight request.",
+						Minimum:     core.ScalarFromInt(0),
+						Maximum:     core.ScalarFromInt(86400),
+					},
+				},
+			},
+
+			// Computed fields
+			"functionUrl": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The HTTP URL endpoint for the function.",
+				Computed:    true,
+			},
+			"functionArn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the function that the URL is for, including the qualifier if one is set.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaURLResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// functionArn is the ID field that must be present in order to update the resource,
+	// the function name and optional qualifier are used to target the function URL.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	functionARN, err := core.GetPathValue(
+		"$.functionArn",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionURL, err := core.GetPathValue(
+		"$.functionUrl",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName, err := core.GetPathValue(
+		"$.functionName",
+		currentStateSpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	saveOpCtxData := map[string]any{
+		"functionName": core.StringValue(functionName),
+	}
+	qualifier, hasQualifier := pluginutils.GetValueByPath("$.qualifier", currentStateSpecData)
+	if hasQualifier {
+		saveOpCtxData["qualifier"] = core.StringValue(qualifier)
+	}
+
+	updateOperations := []pluginutils.SaveOperation[Service]{
+		&functionURLUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(functionARN),
+			Data:               saveOpCtxData,
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.functionArn": functionARN,
+			"spec.functionUrl": functionURL,
+		},
+	}, nil
+}
+
+func changesToUpdateFunctionURLInput(
+	functionName string,
+	qualifier string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	changes *provider.Changes,
+) (*lambda.UpdateFunctionUrlConfigInput, bool) {
+	modifiedFields := pluginutils.MergeFieldChanges(changes.ModifiedFields, changes.NewFields)
+
+	input := &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.UpdateFunctionUrlConfigInput]{
+		pluginutils.NewValueSetter(
+			"$.authType",
+			func(value *core.MappingNode, input *lambda.UpdateFunctionUrlConfigInput) {
+				input.AuthType = types.FunctionUrlAuthType(core.StringValue(value))
+			},
+			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateFunctionUrlConfigInput](true),
+			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateFunctionUrlConfigInput](
+				modifiedFields,
+				"spec",
+			),
+		),
+		pluginutils.NewValueSetter(
+			"$.invokeMode",
+			func(value *core.MappingNode, input *lambda.UpdateFunctionUrlConfigInput) {
+				input.InvokeMode = types.InvokeMode(core.StringValue(value))
+			},
+			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateFunctionUrlConfigInput](true),
+			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateFunctionUrlConfigInput](
+				modifiedFields,
+				"spec",
+			),
+		),
+		pluginutils.NewValueSetter(
+			"$.cors",
+			func(value *core.MappingNode, input *lambda.UpdateFunctionUrlConfigInput) {
+				input.Cors = functionURLCorsFromSpec(value)
+			},
+			pluginutils.WithValueSetterCheckIfChanged[*lambda.UpdateFunctionUrlConfigInput](true),
+			pluginutils.WithValueSetterModifiedFields[*lambda.UpdateFunctionUrlConfigInput](
+				modifiedFields,
+				"spec",
+			),
+		),
+	}
+
+	hasUpdates := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasUpdates = hasUpdates || valueSetter.DidSet()
+	}
+
+	// CORS settings that have been removed from the spec need to be
+	// explicitly cleared, otherwise AWS will keep the existing settings.
+	_, hasCors := pluginutils.GetValueByPath("$.cors", specData)
+	_, hadCors := pluginutils.GetValueByPath("$.cors", currentStateSpecData)
+	if !hasCors && hadCors {
+		input.Cors = functionURLCorsFromSpec(nil)
+		hasUpdates = true
+	}
+
+	return input, hasUpdates
+}
@@ -0,0 +1,44 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/pluginutils"
+)
+
+type functionURLUpdate struct {
+	input *lambda.UpdateFunctionUrlConfigInput
+}
+
+func (u *functionURLUpdate) Name() string {
+	return "function URL"
+}
+
+func (u *functionURLUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	functionName, qualifier := functionQualifierFromSaveOpContext(saveOpCtx)
+	input, hasUpdates := changesToUpdateFunctionURLInput(
+		functionName,
+		qualifier,
+		specData,
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+		changes,
+	)
+	u.input = input
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *functionURLUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := lambdaService.UpdateFunctionUrlConfig(ctx, u.input)
+	return saveOpCtx, err
+}
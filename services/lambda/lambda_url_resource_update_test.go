@@ -0,0 +1,326 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/celerity-provider-aws/internal/testutils"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+	"github.com/newstack-cloud/celerity/libs/blueprint/schema"
+	"github.com/newstack-cloud/celerity/libs/blueprint/state"
+	"github.com/newstack-cloud/celerity/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaURLResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaURLResourceUpdateSuite) Test_update_lambda_url() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		createFunctionURLAuthAndCorsUpdateTestCase(providerCtx, loader),
+		createFunctionURLRemoveCorsUpdateTestCase(providerCtx, loader),
+		createFunctionURLUpdateFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		URLResource,
+		&s.Suite,
+	)
+}
+
+func createFunctionURLAuthAndCorsUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	service := createLambdaServiceMock(
+		WithUpdateFunctionUrlConfigOutput(&lambda.UpdateFunctionUrlConfigOutput{
+			FunctionArn: aws.String(functionARN),
+			FunctionUrl: aws.String(functionURL),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":  core.MappingNodeFromString(functionARN),
+			"functionUrl":  core.MappingNodeFromString(functionURL),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"qualifier":    core.MappingNodeFromString("live"),
+			"authType":     core.MappingNodeFromString("NONE"),
+			"invokeMode":   core.MappingNodeFromString("BUFFERED"),
+			"cors": {
+				Fields: map[string]*core.MappingNode{
+					"allowOrigins": core.MappingNodeFromStringSlice([]string{"*"}),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"qualifier":    core.MappingNodeFromString("live"),
+			"authType":     core.MappingNodeFromString("AWS_IAM"),
+			"invokeMode":   core.MappingNodeFromString("BUFFERED"),
+			"cors": {
+				Fields: map[string]*core.MappingNode{
+					"allowOrigins": core.MappingNodeFromStringSlice([]string{"https://tools.example.com"}),
+					"allowMethods": core.MappingNodeFromStringSlice([]string{"GET"}),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update function URL auth type and CORS settings",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-url-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-url-id",
+					ResourceName: "TestFunctionURL",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-url-id",
+						Name:       "TestFunctionURL",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/url",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.authType",
+					},
+					{
+						FieldPath: "spec.cors.allowOrigins[0]",
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.cors.allowMethods",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(functionARN),
+				"spec.functionUrl": core.MappingNodeFromString(functionURL),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionUrlConfig": &lambda.UpdateFunctionUrlConfigInput{
+				FunctionName: aws.String("test-function"),
+				Qualifier:    aws.String("live"),
+				AuthType:     types.FunctionUrlAuthTypeAwsIam,
+				Cors: &types.Cors{
+					AllowOrigins: []string{"https://tools.example.com"},
+					AllowMethods: []string{"GET"},
+				},
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"CreateFunctionUrlConfig",
+		},
+	}
+}
+
+func createFunctionURLRemoveCorsUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	service := createLambdaServiceMock(
+		WithUpdateFunctionUrlConfigOutput(&lambda.UpdateFunctionUrlConfigOutput{
+			FunctionArn: aws.String(functionARN),
+			FunctionUrl: aws.String(functionURL),
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":  core.MappingNodeFromString(functionARN),
+			"functionUrl":  core.MappingNodeFromString(functionURL),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"authType":     core.MappingNodeFromString("AWS_IAM"),
+			"cors": {
+				Fields: map[string]*core.MappingNode{
+					"allowOrigins": core.MappingNodeFromStringSlice([]string{"*"}),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"authType":     core.MappingNodeFromString("AWS_IAM"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update function URL to clear CORS settings",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-url-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-url-id",
+					ResourceName: "TestFunctionURL",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-url-id",
+						Name:       "TestFunctionURL",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/url",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				RemovedFields: []string{
+					"spec.cors",
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn": core.MappingNodeFromString(functionARN),
+				"spec.functionUrl": core.MappingNodeFromString(functionURL),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionUrlConfig": &lambda.UpdateFunctionUrlConfigInput{
+				FunctionName: aws.String("test-function"),
+				Cors:         &types.Cors{},
+			},
+		},
+	}
+}
+
+func createFunctionURLUpdateFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, Service] {
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	functionURL := "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/"
+
+	service := createLambdaServiceMock(
+		WithUpdateFunctionUrlConfigError(errors.New("failed to update function URL")),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionArn":  core.MappingNodeFromString(functionARN),
+			"functionUrl":  core.MappingNodeFromString(functionURL),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"authType":     core.MappingNodeFromString("AWS_IAM"),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"authType":     core.MappingNodeFromString("NONE"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, Service]{
+		Name: "update function URL failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-url-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-url-id",
+					ResourceName: "TestFunctionURL",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-url-id",
+						Name:       "TestFunctionURL",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/url",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.authType",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaURLResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaURLResourceUpdateSuite))
+}
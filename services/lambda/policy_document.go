@@ -0,0 +1,126 @@
+package lambda
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// policyDocument represents the subset of an IAM resource-based policy document
+// that is returned by Lambda for functions and layer versions.
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	ID        string            `json:"Id"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Sid       string                                    `json:"Sid"`
+	Effect    string                                    `json:"Effect"`
+	Principal policyPrincipal                           `json:"Principal"`
+	Action    policyStringOrSlice                       `json:"Action"`
+	Resource  policyStringOrSlice                       `json:"Resource"`
+	Condition map[string]map[string]policyStringOrSlice `json:"Condition"`
+}
+
+// policyPrincipal holds the principal of a policy statement which
+// can either be a wildcard string ("*") or a map of principal types
+// such as "Service" or "AWS" to one or more principals.
+type policyPrincipal struct {
+	Wildcard   bool
+	Principals map[string]policyStringOrSlice
+}
+
+func (p *policyPrincipal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		p.Wildcard = wildcard == "*"
+		return nil
+	}
+
+	return json.Unmarshal(data, &p.Principals)
+}
+
+// policyStringOrSlice holds a policy value that can either be
+// a single string or a list of strings.
+type policyStringOrSlice []string
+
+func (s *policyStringOrSlice) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = policyStringOrSlice{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*s = multiple
+	return nil
+}
+
+// First returns the first value or an empty string if there are no values.
+func (s policyStringOrSlice) First() string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[0]
+}
+
+func parsePolicyDocument(policy string) (*policyDocument, error) {
+	document := &policyDocument{}
+	err := json.Unmarshal([]byte(policy), document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// findStatement returns the statement with the provided Sid
+// or nil if there is no statement with the given Sid in the document.
+func (d *policyDocument) findStatement(sid string) *policyStatement {
+	for i := range d.Statement {
+		if d.Statement[i].Sid == sid {
+			return &d.Statement[i]
+		}
+	}
+
+	return nil
+}
+
+// conditionValue returns the value for a condition key under the provided
+// condition operator (e.g. "StringEquals" or "ArnLike") or an empty string if
+// the condition is not present in the statement.
+func (s *policyStatement) conditionValue(operator string, key string) string {
+	conditions, hasOperator := s.Condition[operator]
+	if !hasOperator {
+		return ""
+	}
+
+	return conditions[key].First()
+}
+
+var accountRootPrincipalPattern = regexp.MustCompile(`^arn:aws[a-zA-Z-]*:iam::(\d{12}):root$`)
+
+// principalValue returns the principal of the statement in the same form that
+// it is provided when saving a permission.
+// AWS expands account IDs into the ARN of the account root user in policy documents,
+// so these are converted back into the account ID so they can be compared with
+// the principal in the resource spec.
+func (s *policyStatement) principalValue() string {
+	if s.Principal.Wildcard {
+		return "*"
+	}
+
+	if service := s.Principal.Principals["Service"].First(); service != "" {
+		return service
+	}
+
+	awsPrincipal := s.Principal.Principals["AWS"].First()
+	if matches := accountRootPrincipalPattern.FindStringSubmatch(awsPrincipal); len(matches) == 2 {
+		return matches[1]
+	}
+
+	return awsPrincipal
+}
@@ -2,6 +2,8 @@ package lambda
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -64,6 +66,51 @@ func (u *tagsUpdate) Execute(
 	return saveOpCtx, nil
 }
 
+type provisionedConcurrencyConfigUpdate struct {
+	putInput    *lambda.PutProvisionedConcurrencyConfigInput
+	deleteInput *lambda.DeleteProvisionedConcurrencyConfigInput
+}
+
+func (u *provisionedConcurrencyConfigUpdate) Name() string {
+	return "provisioned concurrency config"
+}
+
+func (u *provisionedConcurrencyConfigUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	functionName, qualifier := functionQualifierFromSaveOpContext(saveOpCtx)
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+	putInput, deleteInput, hasUpdates := changesToProvisionedConcurrencyConfigInputs(
+		functionName,
+		qualifier,
+		specData,
+		currentStateSpecData,
+	)
+	u.putInput = putInput
+	u.deleteInput = deleteInput
+	return hasUpdates, saveOpCtx, nil
+}
+
+func (u *provisionedConcurrencyConfigUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService Service,
+) (pluginutils.SaveOperationContext, error) {
+	if u.putInput != nil {
+		_, err := lambdaService.PutProvisionedConcurrencyConfig(ctx, u.putInput)
+		return saveOpCtx, err
+	}
+
+	if u.deleteInput != nil {
+		_, err := lambdaService.DeleteProvisionedConcurrencyConfig(ctx, u.deleteInput)
+		return saveOpCtx, err
+	}
+
+	return saveOpCtx, nil
+}
+
 type tagUpdatesInput struct {
 	saveTagsInput   *lambda.TagResourceInput
 	removeTagsInput *lambda.UntagResourceInput
@@ -209,6 +256,12 @@ func extractComputedFieldsFromFunctionConfig(
 			aws.ToString(functionConfiguration.FunctionArn),
 		)
 
+		if aws.ToString(functionConfiguration.CodeSha256) != "" {
+			fields["spec.codeSha256"] = core.MappingNodeFromString(
+				aws.ToString(functionConfiguration.CodeSha256),
+			)
+		}
+
 		if functionConfiguration.SnapStart != nil {
 			fields["spec.snapStartResponseApplyOn"] = core.MappingNodeFromString(
 				string(functionConfiguration.SnapStart.ApplyOn),
@@ -220,3 +273,95 @@ func extractComputedFieldsFromFunctionConfig(
 	}
 	return fields
 }
+
+func functionQualifierFromSaveOpContext(
+	saveOpCtx pluginutils.SaveOperationContext,
+) (string, string) {
+	functionName, _ := saveOpCtx.Data["functionName"].(string)
+	qualifier, _ := saveOpCtx.Data["qualifier"].(string)
+	return functionName, qualifier
+}
+
+func changesToProvisionedConcurrencyConfigInputs(
+	functionName string,
+	qualifier string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+) (
+	*lambda.PutProvisionedConcurrencyConfigInput,
+	*lambda.DeleteProvisionedConcurrencyConfigInput,
+	bool,
+) {
+	path := "$.provisionedConcurrencyConfig.provisionedConcurrentExecutions"
+	newValue, hasNewValue := pluginutils.GetValueByPath(path, specData)
+	currentValue, hasCurrentValue := pluginutils.GetValueByPath(path, currentStateSpecData)
+
+	if hasNewValue && (!hasCurrentValue || core.IntValue(newValue) != core.IntValue(currentValue)) {
+		return &lambda.PutProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(qualifier),
+			ProvisionedConcurrentExecutions: aws.Int32(
+				int32(core.IntValue(newValue)),
+			),
+		}, nil, true
+	}
+
+	if !hasNewValue && hasCurrentValue {
+		return nil, &lambda.DeleteProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(qualifier),
+		}, true
+	}
+
+	return nil, nil, false
+}
+
+func addProvisionedConcurrencyConfigToSpec(
+	ctx context.Context,
+	functionName string,
+	qualifier string,
+	specFields map[string]*core.MappingNode,
+	lambdaService Service,
+) error {
+	provisionedConcurrencyOutput, err := lambdaService.GetProvisionedConcurrencyConfig(
+		ctx,
+		&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: &functionName,
+			Qualifier:    &qualifier,
+		},
+	)
+	if err != nil {
+		var notFoundErr *types.ProvisionedConcurrencyConfigNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+
+	if provisionedConcurrencyOutput.RequestedProvisionedConcurrentExecutions != nil {
+		specFields["provisionedConcurrencyConfig"] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"provisionedConcurrentExecutions": core.MappingNodeFromInt(
+					int(aws.ToInt32(provisionedConcurrencyOutput.RequestedProvisionedConcurrentExecutions)),
+				),
+			},
+		}
+	}
+
+	return nil
+}
+
+// provisionedConcurrencyAllocationError reports a failed allocation of
+// provisioned concurrency as a deploy error so the deployment ends
+// instead of retrying, a failed allocation will not recover without
+// changes to the configuration or the concurrency limits of the account.
+func provisionedConcurrencyAllocationError(target string, statusReason string) error {
+	failureReason := fmt.Sprintf("provisioned concurrency allocation failed for %s", target)
+	if statusReason != "" {
+		failureReason = fmt.Sprintf("%s: %s", failureReason, statusReason)
+	}
+
+	return &provider.ResourceDeployError{
+		FailureReasons: []string{failureReason},
+	}
+}
@@ -0,0 +1,96 @@
+package s3
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/celerity-provider-aws/utils"
+	"github.com/newstack-cloud/celerity/libs/blueprint/core"
+	"github.com/newstack-cloud/celerity/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the Amazon S3 service
+// used by resource implementations that need to stage artifacts such as
+// deployment packages in S3.
+type Service interface {
+	// The HEAD operation retrieves metadata from an object without returning the
+	// object itself. This operation is useful if you're interested only in an
+	// object's metadata.
+	//
+	// If the object doesn't exist, the operation returns an error
+	// of type NotFound.
+	HeadObject(
+		ctx context.Context,
+		params *s3.HeadObjectInput,
+		optFns ...func(*s3.Options),
+	) (*s3.HeadObjectOutput, error)
+	// Retrieves an object from Amazon S3.
+	//
+	// The caller is responsible for closing the body of the returned object.
+	GetObject(
+		ctx context.Context,
+		params *s3.GetObjectInput,
+		optFns ...func(*s3.Options),
+	) (*s3.GetObjectOutput, error)
+	// Adds an object to a bucket.
+	//
+	// Amazon S3 never adds partial objects; if you receive a success response,
+	// Amazon S3 added the entire object to the bucket. If versioning is enabled
+	// for the bucket, the response includes the version ID of the new object.
+	PutObject(
+		ctx context.Context,
+		params *s3.PutObjectInput,
+		optFns ...func(*s3.Options),
+	) (*s3.PutObjectOutput, error)
+}
+
+// NewService creates a new instance of the AWS S3 service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return s3.NewFromConfig(
+		*awsConfig,
+		s3.WithEndpointResolverV2(
+			&s3EndpointResolverV2{
+				providerContext,
+			},
+		),
+		func(options *s3.Options) {
+			usePathStyle, hasUsePathStyle := providerContext.ProviderConfigVariable(
+				"s3UsePathStyle",
+			)
+			if hasUsePathStyle && !core.IsScalarNil(usePathStyle) {
+				options.UsePathStyle = core.BoolValueFromScalar(usePathStyle)
+			}
+		},
+	)
+}
+
+type s3EndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (s *s3EndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params s3.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	s3Aliases := utils.Services["s3"]
+	s3Endpoint, hasS3Endpoint := utils.GetEndpointFromProviderConfig(
+		s.providerContext,
+		"s3",
+		s3Aliases,
+	)
+	if hasS3Endpoint && !core.IsScalarNil(s3Endpoint) {
+		u, err := url.Parse(core.StringValueFromScalar(s3Endpoint))
+		if err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+		return smithyendpoints.Endpoint{
+			URI: *u,
+		}, nil
+	}
+
+	return s3.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
@@ -5,7 +5,13 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
-	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 )
 
 const (
@@ -21,32 +27,322 @@ func ZipInMemory(
 	fileName string,
 	content string,
 ) (string, error) {
-	zipBuffer := bytes.NewBuffer(make([]byte, 0, MaxZipFileSize))
-	zipWriter := zip.NewWriter(zipBuffer)
-	indexFileWriter, err := zipWriter.Create(fileName)
-	if err != nil {
-		return "", err
-	}
-	_, err = io.Copy(indexFileWriter, bytes.NewReader([]byte(content)))
+	zipBytes, err := ZipFilesInMemory([]ArchiveFile{
+		{
+			Name:    fileName,
+			Content: []byte(content),
+		},
+	})
 	if err != nil {
 		return "", err
 	}
 
-	err = zipWriter.Close()
-	if err != nil {
-		return "", err
+	return base64.StdEncoding.EncodeToString(zipBytes), nil
+}
+
+// ZipFilesInMemory creates a zip archive in memory that contains
+// the provided files.
+// File names must be relative paths that resolve to a location inside
+// the root of the archive, absolute paths and paths that traverse
+// outside of the archive root with ".." segments are rejected.
+// The archive is built with ZipDeterministic and can not exceed MaxZipFileSize.
+// This returns the raw bytes of the zip archive.
+func ZipFilesInMemory(files []ArchiveFile) ([]byte, error) {
+	cleanFiles := make([]ArchiveFile, len(files))
+	for i, file := range files {
+		cleanName, err := CleanArchivePath(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		cleanFiles[i] = ArchiveFile{
+			Name:       cleanName,
+			Content:    file.Content,
+			Executable: file.Executable,
+		}
 	}
 
-	zipBytes, err := io.ReadAll(zipBuffer)
+	zipBytes, err := ZipDeterministic(cleanFiles)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if len(zipBytes) > MaxZipFileSize {
-		return "", fmt.Errorf(
+		return nil, fmt.Errorf(
 			"zip file size is too large, the maximum size is %d MB",
 			MaxZipFileSize/(1024*1024),
 		)
 	}
-	return base64.StdEncoding.EncodeToString(zipBytes), nil
+
+	return zipBytes, nil
+}
+
+// CleanArchivePath validates that the provided file name is a relative
+// path that resolves to a location inside the root of an archive
+// and returns the cleaned form of the path.
+// Paths must use forward slashes as the path separator.
+func CleanArchivePath(name string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("archive file name can not be empty")
+	}
+
+	if strings.Contains(name, "\\") {
+		return "", fmt.Errorf(
+			"archive file name %q must use forward slashes as the path separator",
+			name,
+		)
+	}
+
+	if path.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", fmt.Errorf("archive file name %q must be a relative path", name)
+	}
+
+	cleanName := path.Clean(name)
+	if cleanName == "." || cleanName == ".." || strings.HasPrefix(cleanName, "../") {
+		return "", fmt.Errorf(
+			"archive file name %q resolves to a location outside of the archive root",
+			name,
+		)
+	}
+
+	return cleanName, nil
+}
+
+// ArchiveFile represents a file to be added to a zip archive
+// created by the ZipDeterministic function.
+type ArchiveFile struct {
+	// Name is the path of the file in the archive,
+	// this must use forward slashes as the path separator.
+	Name string
+	// Content holds the raw bytes of the file.
+	Content []byte
+	// Executable determines whether the file should be marked as executable
+	// in the archive.
+	Executable bool
+}
+
+// archiveModifiedTime is the timestamp used for all files in archives
+// created by ZipDeterministic, this is the earliest date that can be represented
+// in the MS-DOS date format used by zip archives.
+var archiveModifiedTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ZipDeterministic creates a zip archive in memory that contains the provided files.
+// Files are written in a stable order with fixed timestamps and permissions
+// so that the same set of files will always produce a byte-for-byte
+// identical archive.
+// This returns the raw bytes of the zip archive.
+func ZipDeterministic(files []ArchiveFile) ([]byte, error) {
+	sortedFiles := make([]ArchiveFile, len(files))
+	copy(sortedFiles, files)
+	sort.Slice(sortedFiles, func(i, j int) bool {
+		return sortedFiles[i].Name < sortedFiles[j].Name
+	})
+
+	zipBuffer := &bytes.Buffer{}
+	zipWriter := zip.NewWriter(zipBuffer)
+	for i, file := range sortedFiles {
+		if i > 0 && sortedFiles[i-1].Name == file.Name {
+			return nil, fmt.Errorf("duplicate file %q in archive", file.Name)
+		}
+
+		header := &zip.FileHeader{
+			Name:     file.Name,
+			Method:   zip.Deflate,
+			Modified: archiveModifiedTime,
+		}
+		if file.Executable {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		fileWriter, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = fileWriter.Write(file.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := zipWriter.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return zipBuffer.Bytes(), nil
+}
+
+// CollectArchiveFiles reads the files from the local file system
+// that match the provided path so they can be added to a zip archive.
+// The path can be a directory, a single file or a glob pattern
+// supported by filepath.Match.
+// Relative paths are resolved against rootDir, when rootDir is empty
+// relative paths are resolved against the current working directory.
+// Directories are included recursively with file names relative to the directory,
+// symbolic links to directories are followed unless they point back to
+// a directory that is already being walked.
+// For glob patterns, file names are relative to the longest leading portion
+// of the pattern that does not contain any glob meta characters.
+// Files that are matched more than once are only included once.
+func CollectArchiveFiles(path string, rootDir string) ([]ArchiveFile, error) {
+	matches, baseDir, err := resolveArchivePath(path, rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := collectMatchedArchiveFiles(matches, baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files were found for the path %q", path)
+	}
+
+	return files, nil
+}
+
+func resolveArchivePath(path string, rootDir string) ([]string, string, error) {
+	cleanPath := filepath.Clean(path)
+	if !filepath.IsAbs(cleanPath) && rootDir != "" {
+		cleanPath = filepath.Join(rootDir, cleanPath)
+	}
+
+	if !hasGlobMeta(cleanPath) {
+		info, err := os.Stat(cleanPath)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if info.IsDir() {
+			return []string{cleanPath}, cleanPath, nil
+		}
+		return []string{cleanPath}, filepath.Dir(cleanPath), nil
+	}
+
+	matches, err := filepath.Glob(cleanPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return matches, globBaseDir(cleanPath), nil
+}
+
+func collectMatchedArchiveFiles(matches []string, baseDir string) ([]ArchiveFile, error) {
+	collector := &archiveFileCollector{
+		baseDir:    baseDir,
+		files:      []ArchiveFile{},
+		seen:       map[string]bool{},
+		activeDirs: map[string]bool{},
+	}
+	for _, match := range matches {
+		err := collector.collect(match)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return collector.files, nil
+}
+
+type archiveFileCollector struct {
+	baseDir string
+	files   []ArchiveFile
+	// seen holds the archive names of the files that have already been
+	// collected, so that overlapping matches do not produce duplicate files.
+	seen map[string]bool
+	// activeDirs holds the resolved paths of the directories that are
+	// currently being walked, so that symbolic links to a parent directory
+	// are not followed forever.
+	activeDirs map[string]bool
+}
+
+func (c *archiveFileCollector) collect(filePath string) error {
+	// os.Stat follows symbolic links so linked directories
+	// are walked in the same way as regular directories.
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return c.addFile(filePath, info)
+	}
+
+	realDir, err := filepath.EvalSymlinks(filePath)
+	if err != nil {
+		return err
+	}
+
+	if c.activeDirs[realDir] {
+		return nil
+	}
+	c.activeDirs[realDir] = true
+	defer delete(c.activeDirs, realDir)
+
+	entries, err := os.ReadDir(filePath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err := c.collect(filepath.Join(filePath, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *archiveFileCollector) addFile(filePath string, info fs.FileInfo) error {
+	relativePath, err := filepath.Rel(c.baseDir, filePath)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.ToSlash(relativePath)
+	if c.seen[name] {
+		return nil
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	c.seen[name] = true
+	c.files = append(c.files, ArchiveFile{
+		Name:       name,
+		Content:    content,
+		Executable: info.Mode().Perm()&0111 != 0,
+	})
+	return nil
+}
+
+func hasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+func globBaseDir(pattern string) string {
+	segments := strings.Split(pattern, string(filepath.Separator))
+	baseSegments := []string{}
+	for _, segment := range segments {
+		if hasGlobMeta(segment) {
+			break
+		}
+		baseSegments = append(baseSegments, segment)
+	}
+
+	if len(baseSegments) == 0 {
+		return "."
+	}
+
+	baseDir := strings.Join(baseSegments, string(filepath.Separator))
+	if baseDir == "" {
+		return string(filepath.Separator)
+	}
+	return baseDir
 }
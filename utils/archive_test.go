@@ -70,6 +70,223 @@ func (s *ArchiveSuite) TestZipInMemory() {
 	}
 }
 
+func (s *ArchiveSuite) TestZipDeterministic_produces_identical_archives() {
+	files := []ArchiveFile{
+		{Name: "lib/utils.js", Content: []byte("module.exports = {};")},
+		{Name: "bootstrap", Content: []byte("#!/bin/sh"), Executable: true},
+		{Name: "index.js", Content: []byte("console.log('Hello, World!');")},
+	}
+	reversedFiles := []ArchiveFile{files[2], files[1], files[0]}
+
+	first, err := ZipDeterministic(files)
+	s.Require().NoError(err)
+	second, err := ZipDeterministic(reversedFiles)
+	s.Require().NoError(err)
+	s.Equal(first, second)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(first), int64(len(first)))
+	s.Require().NoError(err)
+	s.Require().Len(zipReader.File, 3)
+	s.Equal("bootstrap", zipReader.File[0].Name)
+	s.Equal(os.FileMode(0755), zipReader.File[0].Mode().Perm())
+	s.Equal("index.js", zipReader.File[1].Name)
+	s.Equal(os.FileMode(0644), zipReader.File[1].Mode().Perm())
+	s.Equal("lib/utils.js", zipReader.File[2].Name)
+	s.Equal(archiveModifiedTime, zipReader.File[2].Modified.UTC())
+}
+
+func (s *ArchiveSuite) TestZipDeterministic_fails_for_duplicate_files() {
+	_, err := ZipDeterministic([]ArchiveFile{
+		{Name: "index.js", Content: []byte("a")},
+		{Name: "index.js", Content: []byte("b")},
+	})
+	s.ErrorContains(err, "duplicate file \"index.js\"")
+}
+
+func (s *ArchiveSuite) TestZipFilesInMemory() {
+	zipBytes, err := ZipFilesInMemory([]ArchiveFile{
+		{Name: "index.js", Content: []byte("require('./lib/utils');")},
+		{Name: "./lib/utils.js", Content: []byte("module.exports = {};")},
+	})
+	s.Require().NoError(err)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	s.Require().NoError(err)
+	s.Require().Len(zipReader.File, 2)
+	s.Equal("index.js", zipReader.File[0].Name)
+	s.Equal("lib/utils.js", zipReader.File[1].Name)
+
+	readCloser, err := zipReader.File[1].Open()
+	s.Require().NoError(err)
+	content, err := io.ReadAll(readCloser)
+	s.Require().NoError(err)
+	s.Equal("module.exports = {};", string(content))
+}
+
+func (s *ArchiveSuite) TestZipFilesInMemory_rejects_paths_outside_of_archive_root() {
+	invalidNames := []string{
+		"",
+		"../index.js",
+		"lib/../../index.js",
+		"/etc/passwd",
+		"lib\\index.js",
+		"..",
+		".",
+	}
+
+	for _, name := range invalidNames {
+		s.Run(name, func() {
+			_, err := ZipFilesInMemory([]ArchiveFile{
+				{Name: name, Content: []byte("content")},
+			})
+			s.Error(err)
+		})
+	}
+}
+
+func (s *ArchiveSuite) TestCollectArchiveFiles() {
+	rootDir := s.T().TempDir()
+	s.writeTestFile(filepath.Join(rootDir, "src", "index.js"), "exports.handler = 1;", 0644)
+	s.writeTestFile(filepath.Join(rootDir, "src", "lib", "utils.js"), "module.exports = {};", 0644)
+	s.writeTestFile(filepath.Join(rootDir, "src", "bootstrap"), "#!/bin/sh", 0755)
+	s.writeTestFile(filepath.Join(rootDir, "src", "README.md"), "# Function", 0644)
+
+	s.Run("collects files from a directory recursively", func() {
+		files, err := CollectArchiveFiles(filepath.Join(rootDir, "src"), "")
+		s.Require().NoError(err)
+		s.ElementsMatch(
+			[]ArchiveFile{
+				{Name: "index.js", Content: []byte("exports.handler = 1;")},
+				{Name: "lib/utils.js", Content: []byte("module.exports = {};")},
+				{Name: "bootstrap", Content: []byte("#!/bin/sh"), Executable: true},
+				{Name: "README.md", Content: []byte("# Function")},
+			},
+			files,
+		)
+	})
+
+	s.Run("collects files matching a glob pattern", func() {
+		files, err := CollectArchiveFiles(filepath.Join(rootDir, "src", "*.js"), "")
+		s.Require().NoError(err)
+		s.Equal(
+			[]ArchiveFile{
+				{Name: "index.js", Content: []byte("exports.handler = 1;")},
+			},
+			files,
+		)
+	})
+
+	s.Run("collects a single file", func() {
+		files, err := CollectArchiveFiles(filepath.Join(rootDir, "src", "lib", "utils.js"), "")
+		s.Require().NoError(err)
+		s.Equal(
+			[]ArchiveFile{
+				{Name: "utils.js", Content: []byte("module.exports = {};")},
+			},
+			files,
+		)
+	})
+
+	s.Run("fails when no files match the glob pattern", func() {
+		_, err := CollectArchiveFiles(filepath.Join(rootDir, "src", "*.py"), "")
+		s.ErrorContains(err, "no files were found")
+	})
+
+	s.Run("fails when the path does not exist", func() {
+		_, err := CollectArchiveFiles(filepath.Join(rootDir, "missing"), "")
+		s.Error(err)
+	})
+}
+
+func (s *ArchiveSuite) TestCollectArchiveFiles_resolves_relative_paths_against_root_dir() {
+	rootDir := s.T().TempDir()
+	s.writeTestFile(filepath.Join(rootDir, "src", "index.js"), "exports.handler = 1;", 0644)
+
+	files, err := CollectArchiveFiles("src", rootDir)
+	s.Require().NoError(err)
+	s.Equal(
+		[]ArchiveFile{
+			{Name: "index.js", Content: []byte("exports.handler = 1;")},
+		},
+		files,
+	)
+}
+
+func (s *ArchiveSuite) TestCollectArchiveFiles_includes_overlapping_matches_once() {
+	rootDir := s.T().TempDir()
+	s.writeTestFile(filepath.Join(rootDir, "src", "index.js"), "exports.handler = 1;", 0644)
+	s.writeTestFile(filepath.Join(rootDir, "src", "lib", "utils.js"), "module.exports = {};", 0644)
+
+	files, err := collectMatchedArchiveFiles(
+		[]string{
+			filepath.Join(rootDir, "src"),
+			filepath.Join(rootDir, "src", "lib"),
+			filepath.Join(rootDir, "src", "lib", "utils.js"),
+		},
+		filepath.Join(rootDir, "src"),
+	)
+	s.Require().NoError(err)
+	s.ElementsMatch(
+		[]ArchiveFile{
+			{Name: "index.js", Content: []byte("exports.handler = 1;")},
+			{Name: "lib/utils.js", Content: []byte("module.exports = {};")},
+		},
+		files,
+	)
+
+	zipBytes, err := ZipDeterministic(files)
+	s.Require().NoError(err)
+	s.NotEmpty(zipBytes)
+}
+
+func (s *ArchiveSuite) TestCollectArchiveFiles_follows_symlinked_directories() {
+	rootDir := s.T().TempDir()
+	s.writeTestFile(filepath.Join(rootDir, "shared", "utils.js"), "module.exports = {};", 0644)
+	s.writeTestFile(filepath.Join(rootDir, "src", "index.js"), "exports.handler = 1;", 0644)
+	s.Require().NoError(
+		os.Symlink(filepath.Join(rootDir, "shared"), filepath.Join(rootDir, "src", "lib")),
+	)
+	// A link back to the parent directory must not be followed forever.
+	s.Require().NoError(
+		os.Symlink(filepath.Join(rootDir, "src"), filepath.Join(rootDir, "src", "self")),
+	)
+
+	s.Run("collects files from a symlinked directory", func() {
+		files, err := CollectArchiveFiles(filepath.Join(rootDir, "src"), "")
+		s.Require().NoError(err)
+		s.ElementsMatch(
+			[]ArchiveFile{
+				{Name: "index.js", Content: []byte("exports.handler = 1;")},
+				{Name: "lib/utils.js", Content: []byte("module.exports = {};")},
+			},
+			files,
+		)
+	})
+
+	s.Run("collects files from a symlinked directory matching a glob pattern", func() {
+		files, err := CollectArchiveFiles(filepath.Join(rootDir, "src", "l*"), "")
+		s.Require().NoError(err)
+		s.Equal(
+			[]ArchiveFile{
+				{Name: "lib/utils.js", Content: []byte("module.exports = {};")},
+			},
+			files,
+		)
+	})
+}
+
+func (s *ArchiveSuite) TestHasGlobMeta_does_not_treat_backslash_as_glob() {
+	s.False(hasGlobMeta("src\\lib"))
+	s.True(hasGlobMeta("src/*.js"))
+	s.True(hasGlobMeta("src/index.j?"))
+	s.True(hasGlobMeta("src/[a-z].js"))
+}
+
+func (s *ArchiveSuite) writeTestFile(path string, content string, mode os.FileMode) {
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.Require().NoError(os.WriteFile(path, []byte(content), mode))
+}
+
 func TestArchiveSuite(t *testing.T) {
 	suite.Run(t, new(ArchiveSuite))
 }
@@ -27,7 +27,9 @@ func AWSServiceList() string {
 // Services is a map of AWS services and their aliases.
 var Services = map[string][]string{
 	"account":  {},
+	"ecr":      {},
 	"lambda":   {},
+	"s3":       {},
 	"dynamodb": {},
 	"sqs":      {},
 }